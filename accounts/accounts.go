@@ -41,6 +41,7 @@ const (
 	MimetypeClique            = "application/x-clique-header"
 	MimetypeConsortium        = "application/x-clique-header"
 	MimetypeTextPlain         = "text/plain"
+	MimetypeSponsoredTxPayer  = "application/x-sponsored-tx-payer"
 )
 
 // Wallet represents a software or hardware wallet that might contain one or more
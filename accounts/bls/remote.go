@@ -0,0 +1,135 @@
+package bls
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/bls"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// SignerAPIVersion is the version of the remote BLS signer API.
+const SignerAPIVersion = "1.0.0"
+
+// Signer is the BLS key holder that signs the finality votes.
+type Signer interface {
+	// FetchValidatingPublicKeys returns the public keys of the keys that can sign
+	FetchValidatingPublicKeys(ctx context.Context) ([][params.BLSPubkeyLength]byte, error)
+	// Sign signs the signing root with the key of the public key in request
+	Sign(ctx context.Context, req *SignRequest) (bls.Signature, error)
+}
+
+// RemoteSigner is a Signer which forwards the signing requests to an external
+// signer over JSON-RPC, so that the BLS keys are kept off the validator host.
+// The external signer must serve the API in "bls" namespace like SignerAPI.
+type RemoteSigner struct {
+	client *rpc.Client
+}
+
+// NewRemoteSigner connects to the external signer at endpoint, the endpoint
+// can be an IPC path, HTTP or websocket URL.
+func NewRemoteSigner(endpoint string) (*RemoteSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	signer := &RemoteSigner{client: client}
+	// Check if reachable
+	var version string
+	if err := client.Call(&version, "bls_version"); err != nil {
+		client.Close()
+		return nil, errors.Wrap(err, "remote BLS signer is not reachable")
+	}
+	if version != SignerAPIVersion {
+		client.Close()
+		return nil, fmt.Errorf("unsupported remote BLS signer version %s, want %s", version, SignerAPIVersion)
+	}
+	return signer, nil
+}
+
+func (signer *RemoteSigner) FetchValidatingPublicKeys(ctx context.Context) ([][params.BLSPubkeyLength]byte, error) {
+	var res []hexutil.Bytes
+	if err := signer.client.CallContext(ctx, &res, "bls_publicKeys"); err != nil {
+		return nil, err
+	}
+	publicKeys := make([][params.BLSPubkeyLength]byte, 0, len(res))
+	for _, publicKey := range res {
+		if len(publicKey) != params.BLSPubkeyLength {
+			return nil, fmt.Errorf("invalid BLS public key length %d", len(publicKey))
+		}
+		var key [params.BLSPubkeyLength]byte
+		copy(key[:], publicKey)
+		publicKeys = append(publicKeys, key)
+	}
+	return publicKeys, nil
+}
+
+func (signer *RemoteSigner) Sign(ctx context.Context, req *SignRequest) (bls.Signature, error) {
+	var res hexutil.Bytes
+	if err := signer.client.CallContext(ctx, &res, "bls_sign",
+		hexutil.Bytes(req.PublicKey), hexutil.Bytes(req.SigningRoot)); err != nil {
+		return nil, err
+	}
+	signature, err := bls.SignatureFromBytes(res)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote BLS signer")
+	}
+	// Don't trust the remote signer blindly, the invalid signature makes the
+	// vote rejected by other nodes.
+	publicKey, err := bls.PublicKeyFromBytes(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !signature.Verify(publicKey, req.SigningRoot) {
+		return nil, errors.New("remote BLS signer returns incorrect signature")
+	}
+	return signature, nil
+}
+
+// Close closes the connection to the external signer.
+func (signer *RemoteSigner) Close() {
+	signer.client.Close()
+}
+
+// SignerAPI serves the BLS keys of a Signer to RemoteSigner, it is registered
+// in "bls" namespace.
+type SignerAPI struct {
+	signer Signer
+}
+
+func NewSignerAPI(signer Signer) *SignerAPI {
+	return &SignerAPI{signer: signer}
+}
+
+// Version returns the version of the signer API.
+func (api *SignerAPI) Version() string {
+	return SignerAPIVersion
+}
+
+// PublicKeys returns the public keys that can be used to sign.
+func (api *SignerAPI) PublicKeys(ctx context.Context) ([]hexutil.Bytes, error) {
+	publicKeys, err := api.signer.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]hexutil.Bytes, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		res = append(res, hexutil.Bytes(publicKey[:]))
+	}
+	return res, nil
+}
+
+// Sign signs the signing root with the key of the public key.
+func (api *SignerAPI) Sign(ctx context.Context, publicKey hexutil.Bytes, signingRoot hexutil.Bytes) (hexutil.Bytes, error) {
+	signature, err := api.signer.Sign(ctx, &SignRequest{
+		PublicKey:   publicKey,
+		SigningRoot: signingRoot,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signature.Marshal(), nil
+}
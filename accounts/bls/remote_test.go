@@ -0,0 +1,82 @@
+package bls
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/bls"
+	"github.com/ethereum/go-ethereum/crypto/bls/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testSigner is a Signer with a single in-memory secret key
+type testSigner struct {
+	secretKey common.SecretKey
+}
+
+func (signer *testSigner) FetchValidatingPublicKeys(ctx context.Context) ([][params.BLSPubkeyLength]byte, error) {
+	var publicKey [params.BLSPubkeyLength]byte
+	copy(publicKey[:], signer.secretKey.PublicKey().Marshal())
+	return [][params.BLSPubkeyLength]byte{publicKey}, nil
+}
+
+func (signer *testSigner) Sign(ctx context.Context, req *SignRequest) (bls.Signature, error) {
+	publicKey := signer.secretKey.PublicKey().Marshal()
+	if string(req.PublicKey) != string(publicKey) {
+		return nil, errors.New("unknown public key")
+	}
+	return signer.secretKey.Sign(req.SigningRoot), nil
+}
+
+func TestRemoteSigner(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	if err != nil {
+		t.Fatalf("Failed to create secret key, err %s", err)
+	}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("bls", NewSignerAPI(&testSigner{secretKey: secretKey})); err != nil {
+		t.Fatalf("Failed to register signer API, err %s", err)
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	signer, err := NewRemoteSigner(httpServer.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect to remote signer, err %s", err)
+	}
+	defer signer.Close()
+
+	publicKeys, err := signer.FetchValidatingPublicKeys(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to fetch public keys, err %s", err)
+	}
+	if len(publicKeys) != 1 || string(publicKeys[0][:]) != string(secretKey.PublicKey().Marshal()) {
+		t.Fatalf("Public keys mismatch, have %x", publicKeys)
+	}
+
+	signingRoot := make([]byte, 32)
+	signingRoot[0] = 1
+	signature, err := signer.Sign(context.Background(), &SignRequest{
+		PublicKey:   publicKeys[0][:],
+		SigningRoot: signingRoot,
+	})
+	if err != nil {
+		t.Fatalf("Failed to sign, err %s", err)
+	}
+	if !signature.Verify(secretKey.PublicKey(), signingRoot) {
+		t.Fatal("Invalid signature from remote signer")
+	}
+
+	// Unknown key is rejected by the remote signer
+	otherKey, _ := bls.RandKey()
+	if _, err := signer.Sign(context.Background(), &SignRequest{
+		PublicKey:   otherKey.PublicKey().Marshal(),
+		SigningRoot: signingRoot,
+	}); err == nil {
+		t.Fatal("Expect error when signing with unknown key")
+	}
+}
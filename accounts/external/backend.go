@@ -166,9 +166,10 @@ func (api *ExternalSigner) SignData(account accounts.Account, mimeType string, d
 		hexutil.Encode(data)); err != nil {
 		return nil, err
 	}
-	// If V is on 27/28-form, convert to 0/1 for Clique
-	if mimeType == accounts.MimetypeClique && (res[64] == 27 || res[64] == 28) {
-		res[64] -= 27 // Transform V from 27/28 to 0/1 for Clique use
+	// If V is on 27/28-form, convert to 0/1 for Clique and sponsored transaction payer
+	if (mimeType == accounts.MimetypeClique || mimeType == accounts.MimetypeSponsoredTxPayer) &&
+		(res[64] == 27 || res[64] == 28) {
+		res[64] -= 27 // Transform V from 27/28 to 0/1 for Clique and payer use
 	}
 	return res, nil
 }
@@ -221,6 +222,13 @@ func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transactio
 	case types.DynamicFeeTxType:
 		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
 		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	case types.SponsoredTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		expiredTime := hexutil.Uint64(tx.ExpiredTime())
+		args.ExpiredTime = &expiredTime
+		payerV, payerR, payerS := tx.RawPayerSignatureValues()
+		args.PayerV, args.PayerR, args.PayerS = (*hexutil.Big)(payerV), (*hexutil.Big)(payerR), (*hexutil.Big)(payerS)
 	default:
 		return nil, fmt.Errorf("unsupported tx type %d", tx.Type())
 	}
@@ -0,0 +1,104 @@
+// blssigner is a minimal external BLS signer for finality votes. It serves the
+// BLS keys in a local keystore over JSON-RPC so that the validator node can sign
+// the votes with --finality.blsremotesigner without holding the keys.
+//
+// The signer serves an IPC endpoint by default, the socket file is only
+// accessible by its owner. The optional HTTP endpoint has no authentication,
+// any process able to reach it can sign with the keys, so it must only listen
+// on an interface restricted to the validator node.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/accounts/bls"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	walletPath   = flag.String("walletpath", "bls_keystore", "the path to BLS wallet secret key")
+	passwordPath = flag.String("passwordpath", "bls_password", "the path to BLS wallet password file")
+	ipcPath      = flag.String("ipcpath", "blssigner.ipc", "IPC socket path, only accessible by its owner (empty = disabled)")
+	addr         = flag.String("addr", "", "HTTP-RPC server listening address, unauthenticated (empty = disabled)")
+	verbosity    = flag.Int("verbosity", int(log.LvlInfo), "log verbosity (0-5)")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage:", os.Args[0], "[-walletpath <path>] [-passwordpath <path>] [-ipcpath <path>] [-addr <host:port>]")
+		flag.PrintDefaults()
+		fmt.Fprintln(os.Stderr, `
+Serves the BLS keys in the wallet to sign finality votes over JSON-RPC.
+
+The HTTP endpoint enabled by -addr has no authentication, anyone who can reach
+it can sign with the keys. Prefer the IPC endpoint, or only listen on a
+loopback or private interface reachable by the validator node.`)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	glogger := log.NewGlogHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(false)))
+	glogger.Verbosity(log.Lvl(*verbosity))
+	log.Root().SetHandler(glogger)
+
+	if *ipcPath == "" && *addr == "" {
+		die("no IPC path nor HTTP address to serve the BLS signer")
+	}
+	wallet, err := bls.New(*walletPath, *passwordPath)
+	if err != nil {
+		die(fmt.Errorf("failed to open BLS wallet: %w", err))
+	}
+	km, err := bls.NewKeyManager(context.Background(), wallet)
+	if err != nil {
+		die(fmt.Errorf("failed to initialize key manager: %w", err))
+	}
+	publicKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	if err != nil {
+		die(fmt.Errorf("failed to fetch BLS public keys: %w", err))
+	}
+	for _, publicKey := range publicKeys {
+		log.Info("Serving BLS key", "public key", fmt.Sprintf("%x", publicKey))
+	}
+	api := bls.NewSignerAPI(km)
+	errc := make(chan error, 1)
+	if *ipcPath != "" {
+		listener, _, err := rpc.StartIPCEndpoint(*ipcPath, []rpc.API{{Namespace: "bls", Service: api}})
+		if err != nil {
+			die(fmt.Errorf("failed to start IPC endpoint: %w", err))
+		}
+		defer listener.Close()
+		log.Info("BLS signer IPC endpoint opened", "path", *ipcPath)
+	}
+	if *addr != "" {
+		server := rpc.NewServer()
+		if err := server.RegisterName("bls", api); err != nil {
+			die(err)
+		}
+		log.Warn("BLS signer HTTP endpoint has no authentication, anyone reaching it can sign with the keys", "addr", *addr)
+		go func() { errc <- http.ListenAndServe(*addr, server) }()
+	}
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+
+	select {
+	case err := <-errc:
+		die(err)
+	case <-sigc:
+		log.Info("BLS signer stopped")
+	}
+}
+
+func die(args ...interface{}) {
+	fmt.Fprintln(os.Stderr, args...)
+	os.Exit(1)
+}
@@ -19,14 +19,18 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/bls"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/vote"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/bls/blst"
 	blsCommon "github.com/ethereum/go-ethereum/crypto/bls/common"
@@ -276,6 +280,43 @@ Generate proof from keyfile or stored encrypted wallet
 
 The keyfile is assumed to contain an unencrypted private key in hexadecimal format.
 You must input either keyfile or a pair of walletpath and passwordpath.
+`,
+			},
+			{
+				Name:   "export-slashing-protection",
+				Usage:  "Export the finality vote slashing protection data",
+				Action: utils.MigrateFlags(slashingProtectionExport),
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.DBEngineFlag,
+					utils.AncientFlag,
+				},
+				ArgsUsage: "<file>",
+				Description: `
+    ronin account export-slashing-protection <file>
+
+Export the latest finality votes signed by the BLS keys of this node to file in
+EIP-3076 slashing protection interchange format. The finality votes are stored as
+signed blocks whose slot is the target block number.
+`,
+			},
+			{
+				Name:   "import-slashing-protection",
+				Usage:  "Import the finality vote slashing protection data",
+				Action: utils.MigrateFlags(slashingProtectionImport),
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.DBEngineFlag,
+					utils.AncientFlag,
+				},
+				ArgsUsage: "<file>",
+				Description: `
+    ronin account import-slashing-protection <file>
+
+Import the finality vote slashing protection data in EIP-3076 interchange format.
+The node refuses to sign any finality vote whose target block number is lower than
+or, with a different block hash, equal to the imported ones. The data must be
+exported from a node of the same chain.
 `,
 			},
 		},
@@ -682,3 +723,53 @@ func blsProofGenerate(ctx *cli.Context) error {
 
 	return nil
 }
+
+func slashingProtectionExport(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+
+	genesis := rawdb.ReadCanonicalHash(db, 0)
+	interchange := vote.NewSlashingProtection(db).Export(genesis)
+	data, err := json.MarshalIndent(interchange, "", "  ")
+	if err != nil {
+		utils.Fatalf("Failed to encode slashing protection data: %v", err)
+	}
+	if err := ioutil.WriteFile(ctx.Args().First(), data, 0600); err != nil {
+		utils.Fatalf("Failed to write slashing protection data: %v", err)
+	}
+	fmt.Printf("Exported slashing protection data of %d keys\n", len(interchange.Data))
+	return nil
+}
+
+func slashingProtectionImport(ctx *cli.Context) error {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to open slashing protection data: %v", err)
+	}
+	defer file.Close()
+
+	interchange, err := vote.ReadInterchange(file)
+	if err != nil {
+		utils.Fatalf("Failed to decode slashing protection data: %v", err)
+	}
+
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+
+	genesis := rawdb.ReadCanonicalHash(db, 0)
+	if err := vote.NewSlashingProtection(db).Import(interchange, genesis); err != nil {
+		utils.Fatalf("Failed to import slashing protection data: %v", err)
+	}
+	fmt.Printf("Imported slashing protection data of %d keys\n", len(interchange.Data))
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -69,6 +70,7 @@ Remove blockchain and state databases`,
 			dbDumpFreezerIndex,
 			dbImportCmd,
 			dbExportCmd,
+			dbBackfillInternalTxsCmd,
 		},
 	}
 	dbInspectCmd = cli.Command{
@@ -243,8 +245,52 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		},
 		Description: "Exports the specified chain data to an RLP encoded stream, optionally gzip-compressed.",
 	}
+	dbBackfillInternalTxsCmd = cli.Command{
+		Action: utils.MigrateFlags(backfillInternalTxs),
+		Name:   "backfill-internaltxs",
+		Usage:  "Re-executes historical blocks to backfill their internal transactions",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.DBEngineFlag,
+			utils.SyncModeFlag,
+			utils.MainnetFlag,
+			utils.RopstenFlag,
+			utils.RinkebyFlag,
+			utils.GoerliFlag,
+			backfillFromFlag,
+			backfillToFlag,
+			backfillReexecFlag,
+		},
+		Description: `This command re-executes the canonical blocks in range [--from, --to] and
+stores their internal transactions, so the internal transactions of the blocks
+processed before --internaltxs was turned on can be queried.
+
+The blocks are executed in order from a single state, which is regenerated
+from the nearest state on disk, at most --reexec blocks before --from, so an
+archive node is not required. The progress is persisted, an interrupted
+backfill is resumed when it's rerun with the same --from.`,
+	}
+
+	backfillFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "First block to backfill the internal transactions",
+		Value: 1,
+	}
+	backfillToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Last block to backfill the internal transactions (default = current head)",
+	}
+	backfillReexecFlag = cli.Uint64Flag{
+		Name:  "reexec",
+		Usage: "Maximum number of blocks re-executed to regenerate the state of the first block",
+		Value: 1024,
+	}
 )
 
+// backfillProgressInterval is the number of blocks backfilled between two
+// progress writes.
+const backfillProgressInterval = 1024
+
 func removeDB(ctx *cli.Context) error {
 	stack, config := makeConfigNode(ctx)
 
@@ -695,3 +741,72 @@ func exportChaindata(ctx *cli.Context) error {
 	db := utils.MakeChainDatabase(ctx, stack, true)
 	return utils.ExportChaindata(ctx.Args().Get(1), kind, exporter(db), stop)
 }
+
+func backfillInternalTxs(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack)
+	defer db.Close()
+	defer chain.Stop()
+
+	var (
+		from   = ctx.Uint64(backfillFromFlag.Name)
+		to     = chain.CurrentBlock().NumberU64()
+		reexec = ctx.Uint64(backfillReexecFlag.Name)
+	)
+	if ctx.IsSet(backfillToFlag.Name) {
+		if head := to; ctx.Uint64(backfillToFlag.Name) > head {
+			return fmt.Errorf("block #%d is beyond the current head #%d", ctx.Uint64(backfillToFlag.Name), head)
+		}
+		to = ctx.Uint64(backfillToFlag.Name)
+	}
+	if from == 0 {
+		return errors.New("genesis block has no internal transactions")
+	}
+	if from > to {
+		return fmt.Errorf("invalid block range %d - %d", from, to)
+	}
+	if !rawdb.ReadStoreInternalTransactionsEnabled(db) {
+		log.Warn("Internal transactions are not recorded for new blocks, run the node with --internaltxs")
+	}
+	// Skip the finished blocks of the interrupted backfill
+	next := from
+	if start, progress, ok := rawdb.ReadInternalTxsBackfillProgress(db); ok && start == from && progress > from && progress <= to {
+		log.Info("Resuming internal transactions backfill", "from", from, "next", progress)
+		next = progress
+	}
+	rawdb.WriteInternalTxsBackfillProgress(db, from, next)
+
+	var (
+		aborted   int32
+		interrupt = make(chan os.Signal, 1)
+	)
+	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+	defer close(interrupt)
+	go func() {
+		if _, ok := <-interrupt; ok {
+			log.Info("Interrupted during internal transactions backfill, stopping")
+			atomic.StoreInt32(&aborted, 1)
+		}
+	}()
+	log.Info("Backfilling internal transactions", "from", next, "to", to)
+
+	// Persist the progress periodically, so the backfill can be resumed
+	// from there.
+	var (
+		start    = time.Now()
+		progress = func(block uint64) {
+			if (block-next)%backfillProgressInterval == 0 {
+				rawdb.WriteInternalTxsBackfillProgress(db, from, block)
+			}
+		}
+	)
+	if err := chain.BackfillInternalTransactions(next, to, reexec, &aborted, progress); err != nil {
+		return fmt.Errorf("internal transactions backfill failed, rerun with the same --from to resume: %v", err)
+	}
+	rawdb.DeleteInternalTxsBackfillProgress(db)
+	log.Info("Backfilled internal transactions", "from", from, "to", to, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
@@ -95,6 +95,7 @@ var (
 		utils.TxPoolGlobalSlotsFlag,
 		utils.TxPoolAccountQueueFlag,
 		utils.TxPoolGlobalQueueFlag,
+		utils.TxPoolPayerSlotsFlag,
 		utils.TxPoolLifetimeFlag,
 		utils.SyncModeFlag,
 		utils.ExitWhenSyncedFlag,
@@ -168,13 +169,27 @@ var (
 		configFileFlag,
 		utils.CatalystFlag,
 		utils.MonitorDoubleSign,
+		utils.MonitorDoubleSignSlashFlag,
 		utils.MonitorFinalityVoteFlag,
+		utils.MonitorFinalityVoteSlashFlag,
+		utils.MonitorValidatorLivenessFlag,
+		utils.MonitorAlertSlackFlag,
+		utils.MonitorAlertWebhookFlag,
+		utils.MonitorAlertPagerDutyFlag,
+		utils.MonitorAlertFileFlag,
+		utils.MonitorAlertSyslogFlag,
+		utils.MonitorAlertMetricsFlag,
+		utils.MonitorAlertRateLimitFlag,
+		utils.MonitorAlertDedupFlag,
 		utils.StoreInternalTransactions,
 		utils.MaxCurVoteAmountPerBlock,
+		utils.VotePoolJournalFlag,
+		utils.VotePoolRejournalFlag,
 		utils.EnableFastFinality,
 		utils.EnableFastFinalitySign,
 		utils.BlsPasswordPath,
 		utils.BlsWalletPath,
+		utils.BlsRemoteSignerFlag,
 		utils.DisableRoninProtocol,
 		utils.AdditionalChainEventFlag,
 		utils.DBEngineFlag,
@@ -206,6 +221,8 @@ var (
 		utils.RPCGlobalGasCapFlag,
 		utils.RPCGlobalEVMTimeoutFlag,
 		utils.RPCGlobalTxFeeCapFlag,
+		utils.RPCTraceCacheTracersFlag,
+		utils.RPCTraceCacheBlocksFlag,
 		utils.AllowUnprotectedTxs,
 		utils.ReadinessEnabledFlag,
 		utils.ReadinessPrometheusEndpointFlag,
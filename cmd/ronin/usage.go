@@ -57,7 +57,18 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.WhitelistFlag,
 			utils.ForceOverrideChainConfigFlag,
 			utils.MonitorDoubleSign,
+			utils.MonitorDoubleSignSlashFlag,
 			utils.MonitorFinalityVoteFlag,
+			utils.MonitorFinalityVoteSlashFlag,
+			utils.MonitorValidatorLivenessFlag,
+			utils.MonitorAlertSlackFlag,
+			utils.MonitorAlertWebhookFlag,
+			utils.MonitorAlertPagerDutyFlag,
+			utils.MonitorAlertFileFlag,
+			utils.MonitorAlertSyslogFlag,
+			utils.MonitorAlertMetricsFlag,
+			utils.MonitorAlertRateLimitFlag,
+			utils.MonitorAlertDedupFlag,
 			utils.StoreInternalTransactions,
 			utils.DisableRoninProtocol,
 			utils.AdditionalChainEventFlag,
@@ -112,6 +123,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.TxPoolGlobalSlotsFlag,
 			utils.TxPoolAccountQueueFlag,
 			utils.TxPoolGlobalQueueFlag,
+			utils.TxPoolPayerSlotsFlag,
 			utils.TxPoolLifetimeFlag,
 		},
 	},
@@ -166,6 +178,8 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.RPCGlobalGasCapFlag,
 			utils.RPCGlobalEVMTimeoutFlag,
 			utils.RPCGlobalTxFeeCapFlag,
+			utils.RPCTraceCacheTracersFlag,
+			utils.RPCTraceCacheBlocksFlag,
 			utils.AllowUnprotectedTxs,
 			utils.JSpathFlag,
 			utils.ExecFlag,
@@ -261,10 +275,13 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 		Name: "FAST FINALITY",
 		Flags: []cli.Flag{
 			utils.MaxCurVoteAmountPerBlock,
+			utils.VotePoolJournalFlag,
+			utils.VotePoolRejournalFlag,
 			utils.EnableFastFinality,
 			utils.EnableFastFinalitySign,
 			utils.BlsPasswordPath,
 			utils.BlsWalletPath,
+			utils.BlsRemoteSignerFlag,
 		},
 	},
 	{
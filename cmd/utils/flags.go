@@ -60,6 +60,7 @@ import (
 	"github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/ethereum/go-ethereum/metrics/influxdb"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/monitor"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -245,10 +246,56 @@ var (
 		Name:  "monitor.doublesign",
 		Usage: "Enable double sign monitoring",
 	}
+	MonitorDoubleSignSlashFlag = cli.BoolFlag{
+		Name:  "monitor.doublesign.slash",
+		Usage: "Submit the double sign evidences to slash indicator contract with the etherbase account (requires --monitor.doublesign and --mine)",
+	}
 	MonitorFinalityVoteFlag = cli.BoolFlag{
 		Name:  "monitor.finalityvote",
 		Usage: "Enable finality vote monitoring",
 	}
+	MonitorFinalityVoteSlashFlag = cli.BoolFlag{
+		Name:  "monitor.finalityvote.slash",
+		Usage: "Submit the finality vote violations to slash indicator contract with the etherbase account (requires --monitor.finalityvote and --mine)",
+	}
+	MonitorValidatorLivenessFlag = cli.BoolFlag{
+		Name:  "monitor.validator",
+		Usage: "Enable validator missed blocks and finality votes monitoring (requires --metrics)",
+	}
+	MonitorAlertSlackFlag = cli.StringFlag{
+		Name:  "monitor.alert.slack",
+		Usage: "Slack incoming webhook URL that receives the monitor alerts (default = $SLACK_WEBHOOK_URL)",
+	}
+	MonitorAlertWebhookFlag = cli.StringFlag{
+		Name:  "monitor.alert.webhook",
+		Usage: "Webhook URL that receives the monitor alerts in JSON",
+	}
+	MonitorAlertPagerDutyFlag = cli.StringFlag{
+		Name:  "monitor.alert.pagerduty",
+		Usage: "PagerDuty Events API v2 routing key that receives the monitor alerts",
+	}
+	MonitorAlertFileFlag = cli.StringFlag{
+		Name:  "monitor.alert.file",
+		Usage: "File that the monitor alerts are appended to",
+	}
+	MonitorAlertSyslogFlag = cli.BoolFlag{
+		Name:  "monitor.alert.syslog",
+		Usage: "Send the monitor alerts to the local syslog daemon",
+	}
+	MonitorAlertMetricsFlag = cli.BoolFlag{
+		Name:  "monitor.alert.metrics",
+		Usage: "Count the monitor alerts in metrics (requires --metrics)",
+	}
+	MonitorAlertRateLimitFlag = cli.IntFlag{
+		Name:  "monitor.alert.ratelimit",
+		Usage: "Maximum number of monitor alerts sent per minute (0 = unlimited)",
+		Value: ethconfig.Defaults.MonitorAlert.RateLimit,
+	}
+	MonitorAlertDedupFlag = cli.DurationFlag{
+		Name:  "monitor.alert.dedup",
+		Usage: "Time window that the same monitor alert is sent only once (0 = no deduplication)",
+		Value: ethconfig.Defaults.MonitorAlert.DedupWindow,
+	}
 	StoreInternalTransactions = cli.BoolFlag{
 		Name:  "internaltxs",
 		Usage: "Enable storing internal transactions to db",
@@ -400,6 +447,11 @@ var (
 		Usage: "Maximum number of non-executable transaction slots for all accounts",
 		Value: ethconfig.Defaults.TxPool.GlobalQueue,
 	}
+	TxPoolPayerSlotsFlag = cli.Uint64Flag{
+		Name:  "txpool.payerslots",
+		Usage: "Maximum number of sponsored transaction slots permitted per payer",
+		Value: ethconfig.Defaults.TxPool.PayerSlots,
+	}
 	TxPoolLifetimeFlag = cli.DurationFlag{
 		Name:  "txpool.lifetime",
 		Usage: "Maximum amount of time non-executable transaction are queued",
@@ -556,6 +608,16 @@ var (
 		Usage: "Sets a cap on transaction fee (in ether) that can be sent via the RPC APIs (0 = no cap)",
 		Value: ethconfig.Defaults.RPCTxFeeCap,
 	}
+	RPCTraceCacheTracersFlag = cli.StringFlag{
+		Name:  "rpc.tracecache.tracers",
+		Usage: "Comma separated list of tracers run on the imported blocks whose results are served by the debug tracing APIs",
+		Value: "",
+	}
+	RPCTraceCacheBlocksFlag = cli.Uint64Flag{
+		Name:  "rpc.tracecache.blocks",
+		Usage: "Number of recent blocks whose trace results are cached (0 = trace cache disabled)",
+		Value: ethconfig.Defaults.TraceCacheBlocks,
+	}
 	// Logging and debug settings
 	EthStatsURLFlag = cli.StringFlag{
 		Name:  "ethstats",
@@ -896,6 +958,17 @@ var (
 		Value: 22,
 	}
 
+	VotePoolJournalFlag = cli.StringFlag{
+		Name:  "votepool.journal",
+		Usage: "Disk journal for finality votes to survive node restarts (disabled if empty)",
+	}
+
+	VotePoolRejournalFlag = cli.DurationFlag{
+		Name:  "votepool.rejournal",
+		Usage: "Time interval to regenerate the finality vote journal",
+		Value: time.Minute,
+	}
+
 	EnableFastFinality = cli.BoolFlag{
 		Name:  "finality.enable",
 		Usage: "Enable fast finality vote",
@@ -912,6 +985,11 @@ var (
 		Value: "bls_password",
 	}
 
+	BlsRemoteSignerFlag = cli.StringFlag{
+		Name:  "finality.blsremotesigner",
+		Usage: "External BLS signer endpoint (IPC path, HTTP or websocket URL) used instead of the local BLS wallet",
+	}
+
 	BlsWalletPath = cli.StringFlag{
 		Name:  "finality.blswalletpath",
 		Usage: "The path to bls wallet secret key",
@@ -1422,10 +1500,13 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 
 func setFastFinality(ctx *cli.Context, cfg *node.Config) {
 	cfg.MaxCurVoteAmountPerBlock = ctx.GlobalInt(MaxCurVoteAmountPerBlock.Name)
+	cfg.VoteJournal = ctx.GlobalString(VotePoolJournalFlag.Name)
+	cfg.VoteRejournal = ctx.GlobalDuration(VotePoolRejournalFlag.Name)
 	cfg.EnableFastFinality = ctx.GlobalBool(EnableFastFinality.Name)
 	cfg.EnableFastFinalitySign = ctx.GlobalBool(EnableFastFinalitySign.Name)
 	cfg.BlsPasswordPath = ctx.GlobalString(BlsPasswordPath.Name)
 	cfg.BlsWalletPath = ctx.GlobalString(BlsWalletPath.Name)
+	cfg.BlsRemoteSigner = ctx.GlobalString(BlsRemoteSignerFlag.Name)
 }
 
 func setSmartCard(ctx *cli.Context, cfg *node.Config) {
@@ -1533,6 +1614,9 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	if ctx.GlobalIsSet(TxPoolGlobalQueueFlag.Name) {
 		cfg.GlobalQueue = ctx.GlobalUint64(TxPoolGlobalQueueFlag.Name)
 	}
+	if ctx.GlobalIsSet(TxPoolPayerSlotsFlag.Name) {
+		cfg.PayerSlots = ctx.GlobalUint64(TxPoolPayerSlotsFlag.Name)
+	}
 	if ctx.GlobalIsSet(TxPoolLifetimeFlag.Name) {
 		cfg.Lifetime = ctx.GlobalDuration(TxPoolLifetimeFlag.Name)
 	}
@@ -1784,6 +1868,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(RPCGlobalTxFeeCapFlag.Name) {
 		cfg.RPCTxFeeCap = ctx.GlobalFloat64(RPCGlobalTxFeeCapFlag.Name)
 	}
+	if ctx.GlobalIsSet(RPCTraceCacheTracersFlag.Name) {
+		cfg.TraceCacheTracers = SplitAndTrim(ctx.GlobalString(RPCTraceCacheTracersFlag.Name))
+	}
+	if ctx.GlobalIsSet(RPCTraceCacheBlocksFlag.Name) {
+		cfg.TraceCacheBlocks = ctx.GlobalUint64(RPCTraceCacheBlocksFlag.Name)
+	}
 	if ctx.GlobalIsSet(NoDiscoverFlag.Name) {
 		cfg.EthDiscoveryURLs, cfg.SnapDiscoveryURLs = []string{}, []string{}
 	} else if ctx.GlobalIsSet(DNSDiscoveryFlag.Name) {
@@ -1886,6 +1976,10 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 		cfg.EnableMonitorDoubleSign = true
 	}
 
+	if ctx.GlobalBool(MonitorDoubleSignSlashFlag.Name) {
+		cfg.EnableDoubleSignSlashing = true
+	}
+
 	if ctx.GlobalBool(AdditionalChainEventFlag.Name) {
 		cfg.EnableAdditionalChainEvent = true
 	}
@@ -1893,6 +1987,46 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalBool(MonitorFinalityVoteFlag.Name) {
 		cfg.EnableMonitorFinalityVote = true
 	}
+
+	if ctx.GlobalBool(MonitorFinalityVoteSlashFlag.Name) {
+		cfg.EnableFinalityVoteSlashing = true
+	}
+
+	if ctx.GlobalBool(MonitorValidatorLivenessFlag.Name) {
+		cfg.EnableMonitorValidatorLiveness = true
+	}
+
+	setMonitorAlert(ctx, &cfg.MonitorAlert)
+}
+
+// setMonitorAlert configures the alert sinks of monitors.
+func setMonitorAlert(ctx *cli.Context, cfg *monitor.AlertConfig) {
+	if ctx.GlobalIsSet(MonitorAlertSlackFlag.Name) {
+		cfg.SlackWebhook = ctx.GlobalString(MonitorAlertSlackFlag.Name)
+	} else if url := os.Getenv("SLACK_WEBHOOK_URL"); url != "" {
+		cfg.SlackWebhook = url
+	}
+	if ctx.GlobalIsSet(MonitorAlertWebhookFlag.Name) {
+		cfg.Webhook = ctx.GlobalString(MonitorAlertWebhookFlag.Name)
+	}
+	if ctx.GlobalIsSet(MonitorAlertPagerDutyFlag.Name) {
+		cfg.PagerDutyRoutingKey = ctx.GlobalString(MonitorAlertPagerDutyFlag.Name)
+	}
+	if ctx.GlobalIsSet(MonitorAlertFileFlag.Name) {
+		cfg.File = ctx.GlobalString(MonitorAlertFileFlag.Name)
+	}
+	if ctx.GlobalIsSet(MonitorAlertSyslogFlag.Name) {
+		cfg.Syslog = ctx.GlobalBool(MonitorAlertSyslogFlag.Name)
+	}
+	if ctx.GlobalIsSet(MonitorAlertMetricsFlag.Name) {
+		cfg.Metrics = ctx.GlobalBool(MonitorAlertMetricsFlag.Name)
+	}
+	if ctx.GlobalIsSet(MonitorAlertRateLimitFlag.Name) {
+		cfg.RateLimit = ctx.GlobalInt(MonitorAlertRateLimitFlag.Name)
+	}
+	if ctx.GlobalIsSet(MonitorAlertDedupFlag.Name) {
+		cfg.DedupWindow = ctx.GlobalDuration(MonitorAlertDedupFlag.Name)
+	}
 }
 
 // SetDNSDiscoveryDefaults configures DNS discovery with the given URL if
@@ -1934,6 +2068,21 @@ func RegisterEthService(stack *node.Node, cfg *ethconfig.Config) (ethapi.Backend
 		}
 	}
 	stack.RegisterAPIs(tracers.APIs(backend.APIBackend))
+	// Serve the chain tracing stream over HTTP along with the debug APIs
+	for _, module := range stack.Config().HTTPModules {
+		if module == "debug" {
+			handler := node.NewHTTPHandlerStack(tracers.NewTraceChainHandler(backend.APIBackend), stack.Config().HTTPCors, stack.Config().HTTPVirtualHosts)
+			stack.RegisterHandler("Trace chain stream", "/debug/traceChain", handler)
+			break
+		}
+	}
+	if cfg.TraceCacheBlocks > 0 && len(cfg.TraceCacheTracers) > 0 {
+		cache, err := tracers.NewTraceCache(backend.APIBackend, cfg.TraceCacheTracers, cfg.TraceCacheBlocks)
+		if err != nil {
+			Fatalf("Failed to create the trace cache: %v", err)
+		}
+		stack.RegisterLifecycle(cache)
+	}
 	return backend.APIBackend, backend
 }
 
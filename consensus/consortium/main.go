@@ -262,23 +262,26 @@ func (c *Consortium) GetActiveValidatorAt(
 // HandleSystemTransaction fixes up the statedb when system transaction
 // goes through ApplyMessage when tracing/debugging
 func HandleSystemTransaction(engine consensus.Engine, statedb *state.StateDB, msg core.Message, block *types.Block) bool {
+	if !IsSystemMessage(engine, msg, block) {
+		return false
+	}
+	if msg.Value().Cmp(common.Big0) > 0 {
+		balance := statedb.GetBalance(consensus.SystemAddress)
+		statedb.SetBalance(consensus.SystemAddress, big.NewInt(0))
+		statedb.AddBalance(block.Coinbase(), balance)
+	}
+	return true
+}
+
+// IsSystemMessage reports whether the message is a system transaction of the
+// given block, without touching any state.
+func IsSystemMessage(engine consensus.Engine, msg core.Message, block *types.Block) bool {
 	consortium, ok := engine.(*Consortium)
 	if !ok {
 		return false
 	}
-
-	if consortium.chainConfig.IsConsortiumV2(new(big.Int).Add(block.Number(), common.Big1)) {
-		isSystemMsg := consortium.v2.IsSystemMessage(msg, block.Header())
-		if isSystemMsg {
-			if msg.Value().Cmp(common.Big0) > 0 {
-				balance := statedb.GetBalance(consensus.SystemAddress)
-				statedb.SetBalance(consensus.SystemAddress, big.NewInt(0))
-				statedb.AddBalance(block.Coinbase(), balance)
-			}
-
-			return true
-		}
+	if !consortium.chainConfig.IsConsortiumV2(new(big.Int).Add(block.Number(), common.Big1)) {
+		return false
 	}
-
-	return false
+	return consortium.v2.IsSystemMessage(msg, block.Header())
 }
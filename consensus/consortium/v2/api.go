@@ -1,12 +1,26 @@
 package v2
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	consortiumCommon "github.com/ethereum/go-ethereum/consensus/consortium/common"
 	"github.com/ethereum/go-ethereum/consensus/consortium/v2/finality"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/monitor"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// maxValidatorStatsRange is the maximum number of blocks in one
+// GetValidatorStats request, it is about 1 day of blocks.
+const maxValidatorStatsRange = 28800
+
+var errInvalidBlockRange = errors.New("invalid block range")
+
 type consortiumV2Api struct {
 	chain      consensus.ChainHeaderReader
 	consortium *Consortium
@@ -78,3 +92,176 @@ func (api *consortiumV2Api) GetFinalityVoteAtHash(hash common.Hash) (*finalityVo
 
 	return &vote, nil
 }
+
+// resolveBlockNumber converts the rpc block number to the actual block number,
+// the finalized and safe blocks are the finalized and justified blocks from the
+// view of the current head.
+func (api *consortiumV2Api) resolveBlockNumber(number rpc.BlockNumber) (uint64, error) {
+	if number >= 0 {
+		return uint64(number), nil
+	}
+	head := api.chain.CurrentHeader()
+	switch number {
+	case rpc.LatestBlockNumber:
+		return head.Number.Uint64(), nil
+	case rpc.FinalizedBlockNumber:
+		finalized, _ := api.consortium.GetFinalizedBlock(api.chain, head.Number.Uint64(), head.Hash())
+		return finalized, nil
+	case rpc.SafeBlockNumber:
+		justified, _ := api.consortium.GetJustifiedBlock(api.chain, head.Number.Uint64(), head.Hash())
+		return justified, nil
+	default:
+		return 0, fmt.Errorf("unsupported block number %d", number)
+	}
+}
+
+// GetValidatorStats returns the number of in-turn slots missed, out-of-turn blocks
+// sealed and finality votes included of each validator in the block range
+// [fromBlock, toBlock]
+func (api *consortiumV2Api) GetValidatorStats(fromBlock, toBlock rpc.BlockNumber) ([]*monitor.ValidatorStats, error) {
+	from, err := api.resolveBlockNumber(fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.resolveBlockNumber(toBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > to || to-from >= maxValidatorStatsRange {
+		return nil, errInvalidBlockRange
+	}
+
+	collector := monitor.NewValidatorStatsCollector()
+	for number := from; number <= to; number++ {
+		if number == 0 || !api.consortium.chainConfig.IsConsortiumV2(new(big.Int).SetUint64(number)) {
+			continue
+		}
+		header := api.chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, consortiumCommon.ErrUnknownBlock
+		}
+
+		snap, err := api.consortium.snapshot(api.chain, number-1, header.ParentHash, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var voters []common.Address
+		isShillin := api.consortium.chainConfig.IsShillin(header.Number)
+		if isShillin {
+			extraData, err := finality.DecodeExtra(header.Extra, isShillin)
+			if err != nil {
+				return nil, err
+			}
+			if extraData.HasFinalityVote == 1 {
+				for _, pos := range extraData.FinalityVotedValidators.Indices() {
+					if pos < len(snap.ValidatorsWithBlsPub) {
+						voters = append(voters, snap.ValidatorsWithBlsPub[pos].Address)
+					}
+				}
+			}
+		}
+
+		collector.Record(number, snap.validators(), header.Coinbase, voters)
+	}
+
+	return collector.Stats(), nil
+}
+
+// resolveHeader returns the header of the block number or hash
+func (api *consortiumV2Api) resolveHeader(blockNrOrHash rpc.BlockNumberOrHash) (*types.Header, error) {
+	var header *types.Header
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		header = api.chain.GetHeaderByHash(hash)
+	} else if number, ok := blockNrOrHash.Number(); ok {
+		resolved, err := api.resolveBlockNumber(number)
+		if err != nil {
+			return nil, err
+		}
+		header = api.chain.GetHeaderByNumber(resolved)
+	}
+	if header == nil {
+		return nil, consortiumCommon.ErrUnknownBlock
+	}
+	return header, nil
+}
+
+type finalityCheckpoint struct {
+	Number hexutil.Uint64 `json:"number"`
+	Hash   common.Hash    `json:"hash"`
+}
+
+// GetJustifiedBlock returns the latest justified block from the view of the
+// block number or hash, it returns nil if there is no justified block.
+func (api *consortiumV2Api) GetJustifiedBlock(blockNrOrHash rpc.BlockNumberOrHash) (*finalityCheckpoint, error) {
+	header, err := api.resolveHeader(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	number, hash := api.consortium.GetJustifiedBlock(api.chain, header.Number.Uint64(), header.Hash())
+	if number == 0 {
+		return nil, nil
+	}
+	return &finalityCheckpoint{Number: hexutil.Uint64(number), Hash: hash}, nil
+}
+
+// GetFinalizedBlock returns the latest finalized block from the view of the
+// block number or hash, it returns nil if there is no finalized block.
+func (api *consortiumV2Api) GetFinalizedBlock(blockNrOrHash rpc.BlockNumberOrHash) (*finalityCheckpoint, error) {
+	header, err := api.resolveHeader(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	number, hash := api.consortium.GetFinalizedBlock(api.chain, header.Number.Uint64(), header.Hash())
+	if number == 0 {
+		return nil, nil
+	}
+	return &finalityCheckpoint{Number: hexutil.Uint64(number), Hash: hash}, nil
+}
+
+type voterParticipation struct {
+	VotedBlock     hexutil.Uint64 `json:"votedBlock"`     // The block that the finality votes are for
+	VoterCount     int            `json:"voterCount"`     // Number of validators whose votes are included
+	ValidatorCount int            `json:"validatorCount"` // Number of validators that are eligible to vote
+	Participation  float64        `json:"participation"`  // Percentage of eligible validators that voted
+	ReachThreshold bool           `json:"reachThreshold"` // Whether the votes justify the voted block
+}
+
+// GetVoterParticipation returns the percentage of validators whose finality
+// votes for the parent block are included in the block hash.
+func (api *consortiumV2Api) GetVoterParticipation(hash common.Hash) (*voterParticipation, error) {
+	header := api.chain.GetHeaderByHash(hash)
+	if header == nil {
+		return nil, consortiumCommon.ErrUnknownBlock
+	}
+	if header.Number.Uint64() == 0 {
+		return nil, nil
+	}
+
+	snap, err := api.consortium.snapshot(api.chain, header.Number.Uint64()-1, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	participation := &voterParticipation{
+		VotedBlock:     hexutil.Uint64(header.Number.Uint64() - 1),
+		ValidatorCount: len(snap.ValidatorsWithBlsPub),
+	}
+
+	isShillin := api.consortium.chainConfig.IsShillin(header.Number)
+	if isShillin {
+		extraData, err := finality.DecodeExtra(header.Extra, isShillin)
+		if err != nil {
+			return nil, err
+		}
+		if extraData.HasFinalityVote == 1 {
+			participation.VoterCount = len(extraData.FinalityVotedValidators.Indices())
+		}
+	}
+	if participation.ValidatorCount != 0 {
+		participation.Participation = float64(participation.VoterCount) * 100 / float64(participation.ValidatorCount)
+		participation.ReachThreshold = participation.VoterCount >= finality.FinalityThreshold(participation.ValidatorCount)
+	}
+	return participation, nil
+}
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"math"
 	"math/big"
 	"math/rand"
 	"sort"
@@ -46,8 +45,7 @@ const (
 	wiggleTime          = 1000 * time.Millisecond // Random delay (per signer) to allow concurrent signers
 	unSealableValidator = -1
 
-	finalityRatio                  float64 = 2.0 / 3
-	assemblingFinalityVoteDuration         = 1 * time.Second
+	assemblingFinalityVoteDuration = 1 * time.Second
 )
 
 // Consortium delegated proof-of-stake protocol constants.
@@ -279,7 +277,7 @@ func (c *Consortium) verifyFinalitySignatures(
 	}
 
 	votedValidatorPositions := finalityVotedValidators.Indices()
-	if len(votedValidatorPositions) < int(math.Floor(finalityRatio*float64(len(snap.ValidatorsWithBlsPub))))+1 {
+	if len(votedValidatorPositions) < finality.FinalityThreshold(len(snap.ValidatorsWithBlsPub)) {
 		return finality.ErrNotEnoughFinalityVote
 	}
 
@@ -1172,15 +1170,20 @@ func (c *Consortium) readSignerAndContract() (
 // GetBestParentBlock goes backward in the canonical chain to find if the miner can
 // create a chain which has more difficulty than current chain. In case the miner
 // cannot create a better chain, this function returns the head block of current
-// canonical chain.
+// canonical chain. The parent block is never below the finalized block.
 func (c *Consortium) GetBestParentBlock(chain *core.BlockChain) (*types.Block, bool) {
 	signer, _, _, _ := c.readSignerAndContract()
 
 	currentBlock := chain.CurrentBlock()
+	finalizedNumber, _ := c.GetFinalizedBlock(chain, currentBlock.NumberU64(), currentBlock.Hash())
 	block := currentBlock
 	prevBlock := chain.GetBlockByHash(block.ParentHash())
 	diffculty := block.Difficulty().Int64()
 	for diffculty < diffInTurn.Int64() {
+		// Building on a block below the finalized one conflicts with it
+		if prevBlock == nil || prevBlock.NumberU64() < finalizedNumber {
+			return currentBlock, false
+		}
 		snap, err := c.snapshot(chain, block.NumberU64()-1, block.ParentHash(), nil)
 		if err != nil {
 			return currentBlock, false
@@ -1228,7 +1231,7 @@ func (c *Consortium) assembleFinalityVote(header *types.Header, snap *Snapshot)
 		var (
 			signatures              []blsCommon.Signature
 			finalityVotedValidators finality.FinalityVoteBitSet
-			finalityThreshold       int = int(math.Floor(finalityRatio*float64(len(snap.ValidatorsWithBlsPub)))) + 1
+			finalityThreshold       int = finality.FinalityThreshold(len(snap.ValidatorsWithBlsPub))
 		)
 
 		// We assume the signature has been verified in vote pool
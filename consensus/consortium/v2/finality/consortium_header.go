@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"math"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -17,6 +18,8 @@ import (
 const (
 	ExtraSeal   = crypto.SignatureLength
 	ExtraVanity = 32
+
+	finalityRatio float64 = 2.0 / 3
 )
 
 var (
@@ -68,6 +71,12 @@ var (
 	ErrInvalidTargetNumber = errors.New("invalid target number in vote")
 )
 
+// FinalityThreshold returns the minimum number of finality votes to justify a
+// block when there are validatorCount validators eligible to vote.
+func FinalityThreshold(validatorCount int) int {
+	return int(math.Floor(finalityRatio*float64(validatorCount))) + 1
+}
+
 type ValidatorWithBlsPub struct {
 	Address      common.Address
 	BlsPublicKey blsCommon.PublicKey
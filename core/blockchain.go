@@ -53,6 +53,10 @@ var (
 	headHeaderGauge    = metrics.NewRegisteredGauge("chain/head/header", nil)
 	HeadFastBlockGauge = metrics.NewRegisteredGauge("chain/head/receipt", nil)
 
+	headJustifiedBlockGauge = metrics.NewRegisteredGauge("chain/head/justified", nil)
+	headFinalizedBlockGauge = metrics.NewRegisteredGauge("chain/head/finalized", nil)
+	finalityLagGauge        = metrics.NewRegisteredGauge("chain/finality/lag", nil)
+
 	accountReadTimer   = metrics.NewRegisteredTimer("chain/account/reads", nil)
 	accountHashTimer   = metrics.NewRegisteredTimer("chain/account/hashes", nil)
 	accountUpdateTimer = metrics.NewRegisteredTimer("chain/account/updates", nil)
@@ -72,10 +76,11 @@ var (
 	blockExecutionTimer  = metrics.NewRegisteredTimer("chain/execution", nil)
 	blockWriteTimer      = metrics.NewRegisteredTimer("chain/write", nil)
 
-	blockReorgMeter         = metrics.NewRegisteredMeter("chain/reorg/executes", nil)
-	blockReorgAddMeter      = metrics.NewRegisteredMeter("chain/reorg/add", nil)
-	blockReorgDropMeter     = metrics.NewRegisteredMeter("chain/reorg/drop", nil)
-	blockReorgInvalidatedTx = metrics.NewRegisteredMeter("chain/reorg/invalidTx", nil)
+	blockReorgMeter          = metrics.NewRegisteredMeter("chain/reorg/executes", nil)
+	blockReorgAddMeter       = metrics.NewRegisteredMeter("chain/reorg/add", nil)
+	blockReorgDropMeter      = metrics.NewRegisteredMeter("chain/reorg/drop", nil)
+	blockReorgInvalidatedTx  = metrics.NewRegisteredMeter("chain/reorg/invalidTx", nil)
+	blockReorgFinalizedMeter = metrics.NewRegisteredMeter("chain/reorg/finalized", nil)
 
 	blockPrefetchExecuteTimer   = metrics.NewRegisteredTimer("chain/prefetch/executes", nil)
 	blockPrefetchInterruptMeter = metrics.NewRegisteredMeter("chain/prefetch/interrupts", nil)
@@ -447,9 +452,13 @@ func (bc *BlockChain) loadLatestDirtyAccounts() {
 	}
 }
 
-func (bc *BlockChain) StartDoubleSignMonitor() {
+// StartDoubleSignMonitor starts monitoring the new headers for double signing,
+// the detected evidences are stored in the database. The alerter and submitter
+// are optional, when provided, the evidences are sent to the alerter and
+// submitted to SlashIndicator contract.
+func (bc *BlockChain) StartDoubleSignMonitor(alerter monitor.Alerter, submitter *monitor.SlashingSubmitter) {
 	log.Info("Starting double sign monitor")
-	doubleSignMonitor, err := monitor.NewDoubleSignMonitor()
+	doubleSignMonitor, err := monitor.NewDoubleSignMonitor(bc.db, bc.chainConfig.ChainID, alerter, submitter)
 	if err != nil {
 		log.Error("Double sign monitor creation failed", "err", err)
 		return
@@ -479,7 +488,10 @@ func (bc *BlockChain) StartDoubleSignMonitor() {
 	}
 }
 
-func (bc *BlockChain) StartFinalityVoteMonitor() {
+// StartFinalityVoteMonitor starts monitoring the finality votes in new blocks. The
+// alerter and submitter are optional, when provided, the detected violations are
+// sent to the alerter and submitted to SlashIndicator contract.
+func (bc *BlockChain) StartFinalityVoteMonitor(alerter monitor.Alerter, submitter *monitor.SlashingSubmitter) {
 	log.Info("Starting finality vote monitor")
 
 	consensus, ok := bc.engine.(consensus.FastFinalityPoSA)
@@ -487,7 +499,7 @@ func (bc *BlockChain) StartFinalityVoteMonitor() {
 		log.Error("Not a fast finality consensus, stop finality vote monitor")
 		return
 	}
-	finalityVoteMonitor, err := monitor.NewFinalityVoteMonitor(bc, consensus)
+	finalityVoteMonitor, err := monitor.NewFinalityVoteMonitor(bc, consensus, alerter, submitter)
 	if err != nil {
 		log.Error("Finality vote monitor creation failed", "err", err)
 		return
@@ -523,6 +535,37 @@ func (bc *BlockChain) StartFinalityVoteMonitor() {
 	}
 }
 
+// StartValidatorLivenessMonitor starts tracking the block production and finality
+// voting of validators in the new canonical blocks.
+func (bc *BlockChain) StartValidatorLivenessMonitor() {
+	log.Info("Starting validator liveness monitor")
+
+	consensus, ok := bc.engine.(consensus.FastFinalityPoSA)
+	if !ok {
+		log.Error("Not a fast finality consensus, stop validator liveness monitor")
+		return
+	}
+	livenessMonitor := monitor.NewValidatorLivenessMonitor(bc, consensus)
+
+	chainEventCh := make(chan ChainEvent)
+	chainEventSub := bc.SubscribeChainEvent(chainEventCh)
+	defer chainEventSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-chainEventCh:
+			block := ev.Block
+			if bc.chainConfig.IsShillin(block.Number()) {
+				livenessMonitor.CheckBlock(block)
+			}
+		case <-chainEventSub.Err():
+			return
+		case <-bc.quit:
+			return
+		}
+	}
+}
+
 func (bc *BlockChain) EnableAdditionalChainEvent() {
 	bc.enableAdditionalChainEvent = true
 }
@@ -1301,6 +1344,8 @@ func (bc *BlockChain) sendNewBlockEvent(
 		dirtyAccounts        []*types.DirtyStateAccount
 		finalizedBlockNumber uint64
 		finalizedBlockHash   common.Hash
+		justifiedBlockNumber uint64
+		justifiedBlockHash   common.Hash
 	)
 
 	if includeInternalTxsAndDirtyAccounts {
@@ -1310,11 +1355,24 @@ func (bc *BlockChain) sendNewBlockEvent(
 		internalTxs = bc.ReadInternalTransactions(block.Hash())
 	}
 
-	if includeFinalized {
-		finalizedBlock := bc.FinalizedBlock()
-		if finalizedBlock != nil {
-			finalizedBlockNumber = finalizedBlock.NumberU64()
-			finalizedBlockHash = finalizedBlock.Hash()
+	// The finality of the current head is reported along with every canonical
+	// block, only the numbers and hashes are needed so no block is loaded
+	if engine, ok := bc.engine.(consensus.FastFinalityPoSA); ok && includeFinalized {
+		head := bc.CurrentBlock()
+		finalizedBlockNumber, finalizedBlockHash = engine.GetFinalizedBlock(bc, head.NumberU64(), head.Hash())
+		if finalizedBlockNumber != 0 {
+			headFinalizedBlockGauge.Update(int64(finalizedBlockNumber))
+			if head.NumberU64() >= finalizedBlockNumber {
+				finalityLagGauge.Update(int64(head.NumberU64() - finalizedBlockNumber))
+			}
+		} else {
+			finalizedBlockHash = common.Hash{}
+		}
+		justifiedBlockNumber, justifiedBlockHash = engine.GetJustifiedBlock(bc, head.NumberU64(), head.Hash())
+		if justifiedBlockNumber != 0 {
+			headJustifiedBlockGauge.Update(int64(justifiedBlockNumber))
+		} else {
+			justifiedBlockHash = common.Hash{}
 		}
 	}
 
@@ -1332,6 +1390,8 @@ func (bc *BlockChain) sendNewBlockEvent(
 		Receipts:             receipts,
 		FinalizedBlockNumber: finalizedBlockNumber,
 		FinalizedBlockHash:   finalizedBlockHash,
+		JustifiedBlockNumber: justifiedBlockNumber,
+		JustifiedBlockHash:   justifiedBlockHash,
 	})
 }
 
@@ -1381,6 +1441,13 @@ func (bc *BlockChain) WriteBlockWithState(block *types.Block, receipts []*types.
 
 // reorgNeeded determines if the external chain is better than the local chain so reorg is needed
 func (bc *BlockChain) reorgNeeded(localBlock *types.Block, localTd *big.Int, externBlock *types.Block, externTd *big.Int) bool {
+	// The external chain never replaces the finalized block
+	if externBlock.ParentHash() != localBlock.Hash() {
+		if finalized := bc.finalizedHeader(); bc.conflictsWithFinalized(finalized, externBlock.Header()) {
+			bc.reportFinalizedConflict(finalized, externBlock.Header())
+			return false
+		}
+	}
 	if consensusEngine, ok := bc.engine.(consensus.FastFinalityPoSA); ok {
 		localJustifiedBlockNumber, _ := consensusEngine.GetJustifiedBlock(bc, localBlock.NumberU64(), localBlock.Hash())
 		externJustifiedBlockNumber, _ := consensusEngine.GetJustifiedBlock(bc, externBlock.NumberU64(), externBlock.Hash())
@@ -1413,6 +1480,50 @@ func (bc *BlockChain) reorgNeeded(localBlock *types.Block, localTd *big.Int, ext
 	return reorg
 }
 
+// finalizedHeader returns the header of the finalized block of the current head,
+// or nil if there is no finalized block.
+func (bc *BlockChain) finalizedHeader() *types.Header {
+	consensusEngine, ok := bc.engine.(consensus.FastFinalityPoSA)
+	if !ok {
+		return nil
+	}
+	currentBlock := bc.CurrentBlock()
+	finalizedNumber, finalizedHash := consensusEngine.GetFinalizedBlock(bc, currentBlock.NumberU64(), currentBlock.Hash())
+	if finalizedNumber == 0 {
+		return nil
+	}
+	return bc.GetHeader(finalizedHash, finalizedNumber)
+}
+
+// conflictsWithFinalized reports whether the header is on a chain conflicting
+// with the finalized block: the header is either not canonical while not above
+// the finalized block, or not a descendant of the finalized block. The parent of
+// the header must be known.
+func (bc *BlockChain) conflictsWithFinalized(finalized *types.Header, header *types.Header) bool {
+	if finalized == nil {
+		return false
+	}
+	number, finalizedNumber := header.Number.Uint64(), finalized.Number.Uint64()
+	if number <= finalizedNumber {
+		return bc.GetCanonicalHash(number) != header.Hash()
+	}
+	// The import of the header fails anyway if its parent is unknown
+	if !bc.HasHeader(header.ParentHash, number-1) {
+		return false
+	}
+	maxNonCanonical := number - finalizedNumber
+	ancestor, _ := bc.GetAncestor(header.ParentHash, number-1, number-1-finalizedNumber, &maxNonCanonical)
+	return ancestor != finalized.Hash()
+}
+
+// reportFinalizedConflict logs and counts the attempt to replace the finalized
+// block by a conflicting chain.
+func (bc *BlockChain) reportFinalizedConflict(finalized *types.Header, header *types.Header) {
+	log.Warn("Rejected chain conflicting with finalized block", "number", header.Number, "hash", header.Hash(),
+		"finalized", finalized.Number, "finalizedHash", finalized.Hash())
+	blockReorgFinalizedMeter.Mark(1)
+}
+
 // writeBlockWithState writes the block and all associated state to the database,
 // but is expects the chain mutex to be held.
 func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.Receipt, logs []*types.Log, internalTxs []*types.InternalTransaction, state *state.StateDB, emitHeadEvent bool) (status WriteStatus, err error) {
@@ -1533,11 +1644,7 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	}
 
 	if status == CanonStatTy {
-		if bc.enableAdditionalChainEvent {
-			bc.sendNewBlockEvent(block, receipts, true, true)
-		} else {
-			bc.sendNewBlockEvent(block, receipts, false, false)
-		}
+		bc.sendNewBlockEvent(block, receipts, bc.enableAdditionalChainEvent, true)
 		if len(logs) > 0 {
 			bc.logsFeed.Send(logs)
 		}
@@ -1632,6 +1739,20 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 	if bc.insertStopped() {
 		return 0, nil
 	}
+	// Reject the chain conflicting with the finalized block. As the chain is
+	// contiguous, the blocks above the finalized one descend from it if the
+	// first one does.
+	if finalized := bc.finalizedHeader(); finalized != nil {
+		for i, block := range chain {
+			if i > 0 && block.NumberU64() > finalized.Number.Uint64() {
+				break
+			}
+			if bc.conflictsWithFinalized(finalized, block.Header()) {
+				bc.reportFinalizedConflict(finalized, block.Header())
+				return i, ErrFinalizedBlockConflict
+			}
+		}
+	}
 
 	// Start a parallel signature recovery (signer will fluke on fork transition, minimal perf loss)
 	senderCacher.recoverFromBlocks(types.MakeSigner(bc.chainConfig, chain[0].Number()), chain)
@@ -1851,7 +1972,7 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 
 		// store internal txs to db and send them to internalTxFeed
 		if bc.enableAdditionalChainEvent && len(internalTxs) > 0 {
-			bc.WriteInternalTransactions(block.Hash(), internalTxs)
+			bc.WriteInternalTransactions(block.NumberU64(), block.Hash(), internalTxs)
 			bc.internalTxFeed.Send(internalTxs)
 		}
 
@@ -2081,6 +2202,7 @@ func (bc *BlockChain) insertSideChain(block *types.Block, it *insertIterator) (i
 // potential missing transactions and post an event about them.
 func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 	var (
+		newHead     = newBlock
 		newChain    types.Blocks
 		oldChain    types.Blocks
 		commonBlock *types.Block
@@ -2180,6 +2302,11 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 			return fmt.Errorf("invalid new chain")
 		}
 	}
+	// Never rewind the finalized block
+	if finalized := bc.finalizedHeader(); finalized != nil && commonBlock.NumberU64() < finalized.Number.Uint64() {
+		bc.reportFinalizedConflict(finalized, newHead.Header())
+		return ErrFinalizedBlockConflict
+	}
 	// Ensure the user sees large reorgs
 	if len(oldChain) > 0 && len(newChain) > 0 {
 		logFn := log.Info
@@ -2206,7 +2333,7 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 		receipts, _ := collectLogs(newChain[i].Hash(), false)
 
 		if bc.enableAdditionalChainEvent {
-			bc.sendNewBlockEvent(newChain[i], receipts, true, false)
+			bc.sendNewBlockEvent(newChain[i], receipts, true, true)
 		}
 
 		// Collect the new added transactions.
@@ -61,6 +61,20 @@ func (bc *BlockChain) FinalizedBlock() *types.Block {
 	return nil
 }
 
+// JustifiedBlock returns the latest justified block of the current head, it
+// returns nil if there is no justified block.
+func (bc *BlockChain) JustifiedBlock() *types.Block {
+	if consensusEngine, ok := bc.engine.(consensus.FastFinalityPoSA); ok {
+		currentBlock := bc.CurrentBlock()
+		justifiedNumber, justifiedHash := consensusEngine.GetJustifiedBlock(bc, currentBlock.NumberU64(), currentBlock.Hash())
+		if justifiedNumber == 0 {
+			return nil
+		}
+		return rawdb.ReadBlock(bc.db, justifiedHash, justifiedNumber)
+	}
+	return nil
+}
+
 // HasHeader checks if a block header is present in the database or not, caching
 // it if present.
 func (bc *BlockChain) HasHeader(hash common.Hash, number uint64) bool {
@@ -412,7 +426,7 @@ func (bc *BlockChain) SubscribeDirtyAccountEvent(ch chan<- []*types.DirtyStateAc
 	return bc.scope.Track(bc.dirtyAccountFeed.Subscribe(ch))
 }
 
-func (bc *BlockChain) WriteInternalTransactions(hash common.Hash, internalTxs []*types.InternalTransaction) {
+func (bc *BlockChain) WriteInternalTransactions(number uint64, hash common.Hash, internalTxs []*types.InternalTransaction) {
 	// cache first
 	bc.internalTransactionsCache.Add(hash, internalTxs)
 
@@ -421,6 +435,7 @@ func (bc *BlockChain) WriteInternalTransactions(hash common.Hash, internalTxs []
 		return
 	}
 	rawdb.WriteInternalTransactions(bc.db, hash, internalTxs)
+	rawdb.WriteInternalTxAddressIndex(bc.db, number, hash, internalTxs)
 }
 
 func (bc *BlockChain) ReadInternalTransactions(hash common.Hash) []*types.InternalTransaction {
@@ -449,9 +464,12 @@ func (bc *BlockChain) OpEvents() []*vm.PublishEvent {
 		{
 			OpCodes: []vm.OpCode{
 				vm.CALL,
+				vm.CALLCODE,
 				vm.DELEGATECALL,
+				vm.STATICCALL,
 				vm.CREATE,
 				vm.CREATE2,
+				vm.SELFDESTRUCT,
 			},
 			Event: &InternalTransactionEvent{},
 		},
@@ -469,13 +487,17 @@ func (tx *InternalTransactionEvent) Publish(
 	from, to common.Address,
 	value *big.Int,
 	input, output []byte,
+	depth, parentOrder, gas, gasUsed uint64,
 	err error,
 ) *types.InternalTransaction {
 	var msgType string
-	if opcode == vm.CALL || opcode == vm.DELEGATECALL {
-		msgType = types.InternalTransactionContractCall
-	} else {
+	switch opcode {
+	case vm.CREATE, vm.CREATE2:
 		msgType = types.InternalTransactionContractCreation
+	case vm.SELFDESTRUCT:
+		msgType = types.InternalTransactionSelfDestruct
+	default:
+		msgType = types.InternalTransactionContractCall
 	}
 
 	internal := &types.InternalTransaction{
@@ -495,6 +517,11 @@ func (tx *InternalTransactionEvent) Publish(
 			BlockHash:       blockHash,
 			BlockTime:       blockTime,
 		},
+		Version:     types.InternalTransactionVersion1,
+		Depth:       depth,
+		ParentOrder: parentOrder,
+		Gas:         gas,
+		GasUsed:     gasUsed,
 	}
 	if err != nil {
 		internal.Error = err.Error()
@@ -3620,3 +3620,141 @@ func TestSponsoredTxTransition(t *testing.T) {
 		t.Fatalf("Expect sender's balance %d, get %d", want.Uint64(), have.Uint64())
 	}
 }
+
+// finalityStub provides the fast finality methods not used by the tests, it's
+// embedded one level deeper than the ethash engine to not shadow its methods.
+type finalityStub struct {
+	consensus.FastFinalityPoSA
+}
+
+// testFinalityEngine is an ethash faker with fast finality, the canonical blocks
+// at fixed numbers are justified and finalized.
+type testFinalityEngine struct {
+	*ethash.Ethash
+	finalityStub
+	justified uint64
+	finalized uint64
+}
+
+func (e *testFinalityEngine) IsSystemTransaction(tx *types.Transaction, header *types.Header) (bool, error) {
+	return false, nil
+}
+
+func (e *testFinalityEngine) GetJustifiedBlock(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (uint64, common.Hash) {
+	header := chain.GetHeaderByNumber(e.justified)
+	if header == nil || e.justified == 0 || e.justified > number {
+		return 0, common.Hash{}
+	}
+	return e.justified, header.Hash()
+}
+
+func (e *testFinalityEngine) GetFinalizedBlock(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (uint64, common.Hash) {
+	header := chain.GetHeaderByNumber(e.finalized)
+	if header == nil || e.finalized > number {
+		return 0, common.Hash{}
+	}
+	return e.finalized, header.Hash()
+}
+
+// Tests that the chains conflicting with the finalized block are rejected, while
+// the reorgs above the finalized block are still allowed.
+func TestFinalizedBlockReorg(t *testing.T) {
+	var (
+		engine  = &testFinalityEngine{Ethash: ethash.NewFaker(), finalized: 5}
+		db      = rawdb.NewMemoryDatabase()
+		genesis = (&Genesis{BaseFee: big.NewInt(params.InitialBaseFee)}).MustCommit(db)
+	)
+	chain, err := NewBlockChain(db, nil, params.TestChainConfig, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, engine, db, 10, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	}, true)
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert canonical chain: %v", err)
+	}
+	if finalized := chain.FinalizedBlock(); finalized == nil || finalized.Hash() != blocks[4].Hash() {
+		t.Fatalf("finalized block mismatch: have %v, want %x", finalized, blocks[4].Hash())
+	}
+
+	// A heavier fork below the finalized block is rejected
+	fork, _ := GenerateChain(params.TestChainConfig, blocks[2], engine, db, 12, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x02})
+	}, true)
+	if _, err := chain.InsertChain(fork); !errors.Is(err, ErrFinalizedBlockConflict) {
+		t.Fatalf("fork below finalized block error mismatch: have %v, want %v", err, ErrFinalizedBlockConflict)
+	}
+	if head := chain.CurrentBlock(); head.Hash() != blocks[9].Hash() {
+		t.Fatalf("head mismatch after rejected fork: have %d %x, want %d %x", head.NumberU64(), head.Hash(), 10, blocks[9].Hash())
+	}
+	if reorg := chain.reorgNeeded(blocks[9], chain.GetTd(blocks[9].Hash(), 10), fork[1], new(big.Int).Mul(chain.GetTd(blocks[9].Hash(), 10), big.NewInt(2))); reorg {
+		t.Fatal("reorg needed to a fork below finalized block")
+	}
+
+	// A heavier fork above the finalized block is accepted
+	fork, _ = GenerateChain(params.TestChainConfig, blocks[5], engine, db, 12, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x03})
+	}, true)
+	if _, err := chain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to insert fork above finalized block: %v", err)
+	}
+	if head := chain.CurrentBlock(); head.Hash() != fork[len(fork)-1].Hash() {
+		t.Fatalf("head mismatch after fork: have %d %x, want %d %x", head.NumberU64(), head.Hash(), 18, fork[len(fork)-1].Hash())
+	}
+}
+
+// Tests that the chain events of the new heads report the justified and the
+// finalized blocks, even if the additional chain events are disabled.
+func TestChainEventFinality(t *testing.T) {
+	var (
+		engine  = &testFinalityEngine{Ethash: ethash.NewFaker(), justified: 6, finalized: 5}
+		db      = rawdb.NewMemoryDatabase()
+		genesis = (&Genesis{BaseFee: big.NewInt(params.InitialBaseFee)}).MustCommit(db)
+	)
+	chain, err := NewBlockChain(db, nil, params.TestChainConfig, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	chainCh := make(chan ChainEvent, 10)
+	sub := chain.SubscribeChainEvent(chainCh)
+	defer sub.Unsubscribe()
+
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, engine, db, 8, nil, true)
+	go func() {
+		if _, err := chain.InsertChain(blocks); err != nil {
+			t.Errorf("failed to insert chain: %v", err)
+		}
+	}()
+	for i, block := range blocks {
+		select {
+		case ev := <-chainCh:
+			if ev.Hash != block.Hash() {
+				t.Fatalf("event %d: hash mismatch: have %x, want %x", i, ev.Hash, block.Hash())
+			}
+			var (
+				number                       = block.NumberU64()
+				justified, finalized         uint64
+				justifiedHash, finalizedHash common.Hash
+			)
+			if number >= engine.justified {
+				justified, justifiedHash = engine.justified, blocks[engine.justified-1].Hash()
+			}
+			if number >= engine.finalized {
+				finalized, finalizedHash = engine.finalized, blocks[engine.finalized-1].Hash()
+			}
+			if ev.JustifiedBlockNumber != justified || ev.JustifiedBlockHash != justifiedHash {
+				t.Errorf("event %d: justified block mismatch: have %d %x, want %d %x", i, ev.JustifiedBlockNumber, ev.JustifiedBlockHash, justified, justifiedHash)
+			}
+			if ev.FinalizedBlockNumber != finalized || ev.FinalizedBlockHash != finalizedHash {
+				t.Errorf("event %d: finalized block mismatch: have %d %x, want %d %x", i, ev.FinalizedBlockNumber, ev.FinalizedBlockHash, finalized, finalizedHash)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("event %d: chain event timeout", i)
+		}
+	}
+}
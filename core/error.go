@@ -35,6 +35,10 @@ var (
 	errSideChainReceipts = errors.New("side blocks can't be accepted as ancient chain data")
 
 	ErrOutOfOrderSystemTx = errors.New("out-of-order system transaction detected")
+
+	// ErrFinalizedBlockConflict is returned if a block to import or a reorg
+	// conflicts with the locally finalized block.
+	ErrFinalizedBlockConflict = errors.New("chain conflicts with finalized block")
 )
 
 // List of evm-call-message pre-checking errors. All state transition messages will
@@ -34,6 +34,14 @@ type RemovedLogsEvent struct{ Logs []*types.Log }
 // NewVoteEvent is posted when a batch of votes enters the vote pool.
 type NewVoteEvent struct{ Vote *types.VoteEnvelope }
 
+// InvalidVoteEvent is posted when a vote sent by a peer fails the verification
+// of the vote pool.
+type InvalidVoteEvent struct {
+	Peer string
+	Vote *types.VoteEnvelope
+	Err  error
+}
+
 type ChainEvent struct {
 	Block                *types.Block
 	Hash                 common.Hash
@@ -43,6 +51,8 @@ type ChainEvent struct {
 	Receipts             types.Receipts
 	FinalizedBlockNumber uint64
 	FinalizedBlockHash   common.Hash
+	JustifiedBlockNumber uint64
+	JustifiedBlockHash   common.Hash
 }
 
 type FinalizedBlockInfo struct {
@@ -50,6 +60,11 @@ type FinalizedBlockInfo struct {
 	FinalizedBlockHash   common.Hash `json:"finalizedBlockHash"`
 }
 
+type JustifiedBlockInfo struct {
+	JustifiedBlockNumber hexutil.Uint64 `json:"justifiedBlockNumber"`
+	JustifiedBlockHash   common.Hash    `json:"justifiedBlockHash"`
+}
+
 type ChainSideEvent struct {
 	Block *types.Block
 }
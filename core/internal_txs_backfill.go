@@ -0,0 +1,126 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// errBackfillInterrupted is returned when the internal transactions backfill
+// is interrupted by the caller.
+var errBackfillInterrupted = errors.New("internal transactions backfill interrupted")
+
+// BackfillInternalTransactions re-executes the canonical blocks in range
+// [from, to] and writes their internal transactions into the database. The
+// blocks are executed in order on top of an ephemeral state database, the
+// state of the parent of the first block is regenerated once from the nearest
+// state available on disk, at most reexec blocks back, and each block is then
+// executed on the state committed by its parent. So only a single state within
+// the reexec range of the first block is needed, not an archive node.
+//
+// The interrupt flag is checked before each block, the backfill returns an
+// error once it's set. The progress callback, if not nil, is called with the
+// next block to backfill after the internal transactions of a block are written.
+func (bc *BlockChain) BackfillInternalTransactions(from, to uint64, reexec uint64, interrupt *int32, progress func(next uint64)) error {
+	if from == 0 {
+		return errors.New("genesis block has no internal transactions")
+	}
+	if from > to {
+		return fmt.Errorf("invalid block range %d - %d", from, to)
+	}
+	parent := bc.GetBlockByNumber(from - 1)
+	if parent == nil {
+		return fmt.Errorf("block #%d not found", from-1)
+	}
+	database := state.NewDatabaseWithConfig(bc.db, &trie.Config{Cache: 16})
+	statedb, current, err := bc.nearestState(database, parent, reexec)
+	if err != nil {
+		return err
+	}
+	var (
+		start  = time.Now()
+		logged time.Time
+		root   = current.Root()
+	)
+	database.TrieDB().Reference(root, common.Hash{})
+	defer func() { database.TrieDB().Dereference(root) }()
+
+	for current.NumberU64() < to {
+		if interrupt != nil && atomic.LoadInt32(interrupt) != 0 {
+			return errBackfillInterrupted
+		}
+		next := current.NumberU64() + 1
+		if current = bc.GetBlockByNumber(next); current == nil {
+			return fmt.Errorf("block #%d not found", next)
+		}
+		// Only publish the internal transactions of the requested blocks,
+		// the blocks before are executed to regenerate the state.
+		var events []*vm.PublishEvent
+		if next >= from {
+			events = bc.OpEvents()
+		}
+		_, _, internalTxs, _, err := bc.processor.Process(current, statedb, vm.Config{}, events...)
+		if err != nil {
+			return fmt.Errorf("processing block %d failed: %v", next, err)
+		}
+		if len(internalTxs) > 0 {
+			batch := bc.db.NewBatch()
+			rawdb.WriteInternalTransactions(batch, current.Hash(), internalTxs)
+			rawdb.WriteInternalTxAddressIndex(batch, next, current.Hash(), internalTxs)
+			if err := batch.Write(); err != nil {
+				return fmt.Errorf("failed to write internal transactions of block %d: %v", next, err)
+			}
+		}
+		if progress != nil && next >= from {
+			progress(next + 1)
+		}
+		newRoot, err := statedb.Commit(bc.chainConfig.IsEIP158(current.Number()))
+		if err != nil {
+			return fmt.Errorf("state commit failed, number %d root %v: %w", next, current.Root().Hex(), err)
+		}
+		if statedb, err = state.New(newRoot, database, nil); err != nil {
+			return fmt.Errorf("state reset after block %d failed: %v", next, err)
+		}
+		// Hold the state reference and also drop the parent state
+		// to prevent accumulating too many nodes in memory.
+		database.TrieDB().Reference(newRoot, common.Hash{})
+		database.TrieDB().Dereference(root)
+		root = newRoot
+
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Backfilling internal transactions", "block", next, "target", to, "remaining", to-next, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	return nil
+}
+
+// nearestState returns the state of the given block or of its nearest
+// ancestor at most reexec blocks back, along with the block of the state.
+func (bc *BlockChain) nearestState(database state.Database, block *types.Block, reexec uint64) (*state.StateDB, *types.Block, error) {
+	current := block
+	for i := uint64(0); ; i++ {
+		statedb, err := state.New(current.Root(), database, nil)
+		if err == nil {
+			return statedb, current, nil
+		}
+		if i >= reexec {
+			return nil, nil, fmt.Errorf("required historical state of block #%d unavailable (reexec=%d)", block.NumberU64(), reexec)
+		}
+		if current.NumberU64() == 0 {
+			return nil, nil, errors.New("genesis state is missing")
+		}
+		if current = bc.GetBlock(current.ParentHash(), current.NumberU64()-1); current == nil {
+			return nil, nil, fmt.Errorf("missing block %d", block.NumberU64()-i-1)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestBackfillInternalTransactions(t *testing.T) {
+	var (
+		// The contract forwards the call value to the recipient
+		forwarder = common.HexToAddress("0x000000000000000000000000000000000000aaaa")
+		recipient = common.HexToAddress("0x000000000000000000000000000000000000bbbb")
+
+		engine = ethash.NewFaker()
+		db     = rawdb.NewMemoryDatabase()
+
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: GenesisAlloc{
+				address:   {Balance: big.NewInt(params.Ether)},
+				forwarder: {Code: forwarderCode(recipient), Balance: big.NewInt(0)},
+			},
+		}
+		genesis = gspec.MustCommit(db)
+		signer  = types.LatestSigner(gspec.Config)
+	)
+	blocks, _ := GenerateChain(gspec.Config, genesis, engine, db, 8, func(i int, b *BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(address), forwarder, big.NewInt(int64(i+1)), 100000, b.header.BaseFee, nil), signer, key)
+		b.AddTx(tx)
+	}, true)
+
+	diskdb := rawdb.NewMemoryDatabase()
+	gspec.MustCommit(diskdb)
+
+	// Keep all the states on disk, the internal transactions are not stored
+	// while importing the chain.
+	chain, err := NewBlockChain(diskdb, &CacheConfig{TrieDirtyDisabled: true}, gspec.Config, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	if internalTxs := rawdb.ReadInternalTransactions(diskdb, blocks[0].Hash()); len(internalTxs) != 0 {
+		t.Fatalf("unexpected internal transactions before backfill: %d", len(internalTxs))
+	}
+
+	if err := chain.BackfillInternalTransactions(0, 8, 0, nil, nil); err == nil {
+		t.Fatal("expected error when backfilling the genesis block")
+	}
+	var progress []uint64
+	if err := chain.BackfillInternalTransactions(3, 8, 0, nil, func(next uint64) { progress = append(progress, next) }); err != nil {
+		t.Fatalf("failed to backfill internal transactions: %v", err)
+	}
+	if want := []uint64{4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(progress, want) {
+		t.Errorf("progress mismatch: have %v, want %v", progress, want)
+	}
+	for i, block := range blocks {
+		internalTxs := rawdb.ReadInternalTransactions(diskdb, block.Hash())
+		if block.NumberU64() < 3 {
+			if len(internalTxs) != 0 {
+				t.Errorf("block %d: unexpected internal transactions: %d", block.NumberU64(), len(internalTxs))
+			}
+			continue
+		}
+		if len(internalTxs) != 1 {
+			t.Fatalf("block %d: internal transactions mismatch: have %d, want 1", block.NumberU64(), len(internalTxs))
+		}
+		if internalTxs[0].From != forwarder || internalTxs[0].To != recipient {
+			t.Errorf("block %d: internal transaction mismatch: have %x -> %x", block.NumberU64(), internalTxs[0].From, internalTxs[0].To)
+		}
+		if internalTxs[0].Value.Cmp(big.NewInt(int64(i+1))) != 0 {
+			t.Errorf("block %d: value mismatch: have %v, want %d", block.NumberU64(), internalTxs[0].Value, i+1)
+		}
+		if internalTxs[0].Version != types.InternalTransactionVersion1 || internalTxs[0].Depth != 1 || internalTxs[0].ParentOrder != 0 {
+			t.Errorf("block %d: call info mismatch: version %d, depth %d, parent %d", block.NumberU64(), internalTxs[0].Version, internalTxs[0].Depth, internalTxs[0].ParentOrder)
+		}
+		// The recipient has no code, so no gas is used by the call
+		if internalTxs[0].Gas < params.CallStipend || internalTxs[0].GasUsed != 0 {
+			t.Errorf("block %d: gas mismatch: gas %d, used %d", block.NumberU64(), internalTxs[0].Gas, internalTxs[0].GasUsed)
+		}
+	}
+	// The backfilled internal transactions are indexed by address
+	if indexes := rawdb.ReadInternalTxAddressIndex(diskdb, recipient, 0, 8, 0); len(indexes) != 6 {
+		t.Errorf("address index mismatch: have %d, want 6", len(indexes))
+	}
+}
+
+// forwarderCode returns the code of a contract which forwards the call value to
+// the given address.
+func forwarderCode(to common.Address) []byte {
+	code := []byte{
+		byte(vm.PUSH1), 0, // retSize
+		byte(vm.PUSH1), 0, // retOffset
+		byte(vm.PUSH1), 0, // argsSize
+		byte(vm.PUSH1), 0, // argsOffset
+		byte(vm.CALLVALUE),
+		byte(vm.PUSH20),
+	}
+	code = append(code, to.Bytes()...)
+	return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.STOP))
+}
@@ -994,6 +994,35 @@ func WriteStoreInternalTransactionsEnabled(db ethdb.KeyValueWriter, store bool)
 	}
 }
 
+// ReadInternalTxsBackfillProgress retrieves the first block of the interrupted
+// internal transactions backfill and the next block to be backfilled.
+func ReadInternalTxsBackfillProgress(db ethdb.KeyValueReader) (uint64, uint64, bool) {
+	data, _ := db.Get(internalTxsBackfillKey)
+	if len(data) != 16 {
+		return 0, 0, false
+	}
+	return binary.BigEndian.Uint64(data[:8]), binary.BigEndian.Uint64(data[8:]), true
+}
+
+// WriteInternalTxsBackfillProgress stores the first block of the internal
+// transactions backfill and the next block to be backfilled.
+func WriteInternalTxsBackfillProgress(db ethdb.KeyValueWriter, from, next uint64) {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data[:8], from)
+	binary.BigEndian.PutUint64(data[8:], next)
+	if err := db.Put(internalTxsBackfillKey, data); err != nil {
+		log.Crit("Failed to store internal transactions backfill progress", "err", err)
+	}
+}
+
+// DeleteInternalTxsBackfillProgress removes the internal transactions backfill
+// progress once the backfill is done.
+func DeleteInternalTxsBackfillProgress(db ethdb.KeyValueWriter) {
+	if err := db.Delete(internalTxsBackfillKey); err != nil {
+		log.Crit("Failed to delete internal transactions backfill progress", "err", err)
+	}
+}
+
 // WriteDirtyAccounts stores the dirty accounts to db.
 func WriteDirtyAccounts(db ethdb.KeyValueWriter, dirtyStateAccounts []*types.DirtyStateAccountsAndBlock) {
 	data, err := rlp.EncodeToBytes(dirtyStateAccounts)
@@ -945,6 +945,66 @@ func TestReadWriteInternalTransactions(t *testing.T) {
 	}
 }
 
+// Tests that the internal transactions stored before the versioned encoding
+// are still readable, and the versioned fields are stored.
+func TestReadWriteVersionedInternalTransactions(t *testing.T) {
+	db := NewMemoryDatabase()
+	body := &types.InternalTransactionBody{
+		Order:           3,
+		TransactionHash: common.HexToHash("0x4"),
+		Value:           big.NewInt(10),
+		From:            common.HexToAddress("0x1"),
+		To:              common.HexToAddress("0x2"),
+		Height:          100,
+	}
+	// The internal transaction format before the versioned fields are added
+	type legacyInternalTransaction struct {
+		Opcode  string
+		Type    string
+		Success bool
+		Error   string
+		Output  []byte
+		*types.InternalTransactionBody
+	}
+	legacyHash := common.HexToHash("0x5")
+	data, err := rlp.EncodeToBytes([]*legacyInternalTransaction{{Opcode: "CALL", Type: "call", Success: true, InternalTransactionBody: body}})
+	if err != nil {
+		t.Fatalf("failed to encode legacy internal transactions: %v", err)
+	}
+	if err := db.Put(internalTxsKey(legacyHash), data); err != nil {
+		t.Fatalf("failed to store legacy internal transactions: %v", err)
+	}
+	legacy := ReadInternalTransactions(db, legacyHash)
+	if len(legacy) != 1 {
+		t.Fatalf("legacy internal transactions mismatch: have %d, want 1", len(legacy))
+	}
+	if legacy[0].Version != 0 || legacy[0].Depth != 0 || legacy[0].Order != 3 || legacy[0].Value.Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("legacy internal transaction mismatch: %+v", legacy[0])
+	}
+
+	versioned := &types.InternalTransaction{
+		Opcode:                  "SELFDESTRUCT",
+		Type:                    types.InternalTransactionSelfDestruct,
+		Success:                 true,
+		InternalTransactionBody: body,
+		Version:                 types.InternalTransactionVersion1,
+		Depth:                   2,
+		ParentOrder:             1,
+	}
+	versionedHash := common.HexToHash("0x6")
+	WriteInternalTransactions(db, versionedHash, []*types.InternalTransaction{versioned})
+	results := ReadInternalTransactions(db, versionedHash)
+	if len(results) != 1 {
+		t.Fatalf("versioned internal transactions mismatch: have %d, want 1", len(results))
+	}
+	if have := results[0]; have.Version != versioned.Version || have.Depth != 2 || have.ParentOrder != 1 || have.Gas != 0 || have.GasUsed != 0 {
+		t.Fatalf("versioned internal transaction mismatch: have %+v, want %+v", have, versioned)
+	}
+	if results[0].Hash() != legacy[0].Hash() {
+		t.Fatal("internal transaction hash changed by the versioned fields")
+	}
+}
+
 func TestReadWriteDirtyAccounts(t *testing.T) {
 	db := NewMemoryDatabase()
 	blockHash1, blockHash2 := common.HexToHash("0x3"), common.HexToHash("0x4")
@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -141,6 +142,54 @@ func ReadReceipt(db ethdb.Reader, hash common.Hash, config *params.ChainConfig)
 	return nil, common.Hash{}, 0, 0
 }
 
+// WriteInternalTxAddressIndex indexes the block by the from and to addresses of
+// its internal transactions, enabling address based internal transaction lookups.
+func WriteInternalTxAddressIndex(db ethdb.KeyValueWriter, number uint64, hash common.Hash, internalTxs []*types.InternalTransaction) {
+	indexed := make(map[common.Address]struct{})
+	for _, internalTx := range internalTxs {
+		for _, address := range []common.Address{internalTx.From, internalTx.To} {
+			if _, ok := indexed[address]; ok {
+				continue
+			}
+			indexed[address] = struct{}{}
+			if err := db.Put(internalTxAddressKey(address, number, hash), []byte{}); err != nil {
+				log.Crit("Failed to store internal transaction address index", "err", err)
+			}
+		}
+	}
+}
+
+// ReadInternalTxAddressIndex retrieves the blocks having internal transactions
+// from or to the address in the given range, both canonical and reorged blocks
+// are included. This method considers both limits to be _inclusive_. If the
+// accumulated entries reaches the given limit, abort the iteration and return
+// the semi-finish result.
+func ReadInternalTxAddressIndex(db ethdb.Iteratee, address common.Address, from, to uint64, limit int) []*NumberHash {
+	var (
+		prefix    = append(append([]byte{}, internalTxAddressPrefix...), address.Bytes()...)
+		keyLength = len(prefix) + 8 + common.HashLength
+		blocks    []*NumberHash
+		it        = db.NewIterator(prefix, encodeBlockNumber(from))
+	)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != keyLength {
+			continue
+		}
+		number := binary.BigEndian.Uint64(key[len(prefix) : len(prefix)+8])
+		if number > to {
+			break
+		}
+		blocks = append(blocks, &NumberHash{number, common.BytesToHash(key[len(prefix)+8:])})
+		if limit > 0 && len(blocks) >= limit {
+			break
+		}
+	}
+	return blocks
+}
+
 // ReadBloomBits retrieves the compressed bloom bit vector belonging to the given
 // section and bit index from the.
 func ReadBloomBits(db ethdb.KeyValueReader, bit uint, section uint64, head common.Hash) ([]byte, error) {
@@ -177,3 +177,46 @@ func TestDeleteBloomBits(t *testing.T) {
 	check(1, 1, params.MainnetGenesisHash, true)
 	check(1, 1, params.RinkebyGenesisHash, true)
 }
+
+func TestInternalTxAddressIndex(t *testing.T) {
+	db := NewMemoryDatabase()
+
+	var (
+		addr1 = common.HexToAddress("0x1")
+		addr2 = common.HexToAddress("0x2")
+		addr3 = common.HexToAddress("0x3")
+	)
+	internalTx := func(from, to common.Address) *types.InternalTransaction {
+		return &types.InternalTransaction{
+			InternalTransactionBody: &types.InternalTransactionBody{From: from, To: to},
+		}
+	}
+	WriteInternalTxAddressIndex(db, 1, common.Hash{0x1}, []*types.InternalTransaction{
+		internalTx(addr1, addr2), internalTx(addr1, addr3),
+	})
+	WriteInternalTxAddressIndex(db, 2, common.Hash{0x2}, []*types.InternalTransaction{
+		internalTx(addr2, addr3),
+	})
+	WriteInternalTxAddressIndex(db, 3, common.Hash{0x3}, []*types.InternalTransaction{
+		internalTx(addr3, addr1),
+	})
+
+	check := func(address common.Address, from, to uint64, limit int, want []uint64) {
+		t.Helper()
+		blocks := ReadInternalTxAddressIndex(db, address, from, to, limit)
+		if len(blocks) != len(want) {
+			t.Fatalf("Blocks length mismatch, have %d want %d", len(blocks), len(want))
+		}
+		for i, block := range blocks {
+			if block.Number != want[i] || block.Hash != (common.Hash{byte(want[i])}) {
+				t.Fatalf("Block %d mismatch, have %d %x want %d", i, block.Number, block.Hash, want[i])
+			}
+		}
+	}
+	check(addr1, 0, 10, 0, []uint64{1, 3})
+	check(addr2, 0, 10, 0, []uint64{1, 2})
+	check(addr3, 0, 10, 0, []uint64{1, 2, 3})
+	check(addr3, 2, 2, 0, []uint64{2})
+	check(addr3, 0, 10, 2, []uint64{1, 2})
+	check(common.HexToAddress("0x4"), 0, 10, 0, nil)
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -164,3 +165,51 @@ func WriteHighestFinalityVote(db ethdb.KeyValueStore, highestFinalityVote uint64
 		log.Crit("Failed to store highest finality vote", "err", err)
 	}
 }
+
+// ReadSignedVoteRecord retrieves the latest vote signed by the BLS public key.
+func ReadSignedVoteRecord(db ethdb.KeyValueReader, publicKey types.BLSPublicKey) *types.SignedVoteRecord {
+	enc, _ := db.Get(signedVoteKey(publicKey))
+	if len(enc) == 0 {
+		return nil
+	}
+	var record types.SignedVoteRecord
+	if err := rlp.DecodeBytes(enc, &record); err != nil {
+		log.Error("Invalid signed vote record RLP", "publicKey", common.Bytes2Hex(publicKey[:]), "err", err)
+		return nil
+	}
+	return &record
+}
+
+// WriteSignedVoteRecord stores the latest vote signed by the BLS public key.
+func WriteSignedVoteRecord(db ethdb.KeyValueWriter, record *types.SignedVoteRecord) {
+	enc, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		log.Crit("Failed to encode signed vote record", "err", err)
+	}
+	if err := db.Put(signedVoteKey(record.PublicKey), enc); err != nil {
+		log.Crit("Failed to store signed vote record", "err", err)
+	}
+}
+
+// ReadAllSignedVoteRecords retrieves the latest signed votes of all BLS public
+// keys in the database.
+func ReadAllSignedVoteRecords(db ethdb.Iteratee) []*types.SignedVoteRecord {
+	var records []*types.SignedVoteRecord
+
+	it := db.NewIterator(signedVotePrefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(signedVotePrefix)+len(types.BLSPublicKey{}) {
+			continue
+		}
+		var record types.SignedVoteRecord
+		if err := rlp.DecodeBytes(it.Value(), &record); err != nil {
+			log.Error("Invalid signed vote record RLP", "key", common.Bytes2Hex(key), "err", err)
+			continue
+		}
+		records = append(records, &record)
+	}
+	return records
+}
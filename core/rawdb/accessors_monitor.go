@@ -0,0 +1,50 @@
+package rawdb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// WriteDoubleSignEvidence stores the double sign evidence into the database.
+func WriteDoubleSignEvidence(db ethdb.KeyValueWriter, evidence *types.DoubleSignEvidence) {
+	data, err := rlp.EncodeToBytes(evidence)
+	if err != nil {
+		log.Crit("Failed to RLP encode double sign evidence", "err", err)
+	}
+	key := doubleSignEvidenceKey(evidence.Number(), evidence.Header1.Hash(), evidence.Header2.Hash())
+	if err := db.Put(key, data); err != nil {
+		log.Crit("Failed to store double sign evidence", "err", err)
+	}
+}
+
+// ReadDoubleSignEvidences retrieves all the double sign evidences whose block
+// number is in the range [from, to].
+func ReadDoubleSignEvidences(db ethdb.Iteratee, from, to uint64) []*types.DoubleSignEvidence {
+	var evidences []*types.DoubleSignEvidence
+
+	it := db.NewIterator(doubleSignEvidencePrefix, encodeBlockNumber(from))
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if !bytes.HasPrefix(key, doubleSignEvidencePrefix) || len(key) != len(doubleSignEvidencePrefix)+8+2*common.HashLength {
+			continue
+		}
+		if number := binary.BigEndian.Uint64(key[len(doubleSignEvidencePrefix):]); number > to {
+			break
+		}
+		var evidence types.DoubleSignEvidence
+		if err := rlp.DecodeBytes(it.Value(), &evidence); err != nil {
+			log.Error("Invalid double sign evidence RLP", "key", common.Bytes2Hex(key), "err", err)
+			continue
+		}
+		evidences = append(evidences, &evidence)
+	}
+	return evidences
+}
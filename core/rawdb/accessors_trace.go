@@ -0,0 +1,75 @@
+package rawdb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// ReadTraceResults retrieves the json-encoded trace results of the block
+// transactions produced by the given tracer, in the order of the transactions.
+func ReadTraceResults(db ethdb.KeyValueReader, number uint64, hash common.Hash, tracer string) [][]byte {
+	data, _ := db.Get(traceResultsKey(number, hash, tracer))
+	if len(data) == 0 {
+		return nil
+	}
+	var results [][]byte
+	if err := rlp.DecodeBytes(data, &results); err != nil {
+		log.Error("Invalid trace results RLP", "number", number, "hash", hash, "tracer", tracer, "err", err)
+		return nil
+	}
+	return results
+}
+
+// WriteTraceResults stores the json-encoded trace results of the block
+// transactions produced by the given tracer into the database.
+func WriteTraceResults(db ethdb.KeyValueWriter, number uint64, hash common.Hash, tracer string, results [][]byte) {
+	data, err := rlp.EncodeToBytes(results)
+	if err != nil {
+		log.Crit("Failed to RLP encode trace results", "err", err)
+	}
+	if err := db.Put(traceResultsKey(number, hash, tracer), data); err != nil {
+		log.Crit("Failed to store trace results", "err", err)
+	}
+}
+
+// DeleteTraceResultsRange removes the trace results of all the blocks whose
+// number is in range [from, limit), and returns the number of deleted entries.
+// The iteration starts from the given block, so the entries deleted by the
+// previous calls are not scanned again.
+func DeleteTraceResultsRange(db ethdb.KeyValueStore, from, limit uint64) int {
+	it := db.NewIterator(traceResultsPrefix, encodeBlockNumber(from))
+	defer it.Release()
+
+	var (
+		batch   = db.NewBatch()
+		deleted int
+	)
+	for it.Next() {
+		key := it.Key()
+		if !bytes.HasPrefix(key, traceResultsPrefix) || len(key) < len(traceResultsPrefix)+8+common.HashLength {
+			continue
+		}
+		if number := binary.BigEndian.Uint64(key[len(traceResultsPrefix):]); number >= limit {
+			break
+		}
+		if err := batch.Delete(key); err != nil {
+			log.Crit("Failed to delete trace results", "err", err)
+		}
+		deleted++
+		if batch.ValueSize() > ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to delete trace results", "err", err)
+			}
+			batch.Reset()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to delete trace results", "err", err)
+	}
+	return deleted
+}
@@ -0,0 +1,36 @@
+package rawdb
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Tests that the trace results are deleted only in the given block range.
+func TestDeleteTraceResultsRange(t *testing.T) {
+	db := NewMemoryDatabase()
+	for number := uint64(1); number <= 10; number++ {
+		WriteTraceResults(db, number, common.Hash{byte(number)}, "callTracer", [][]byte{[]byte("{}")})
+		WriteTraceResults(db, number, common.Hash{byte(number)}, "prestateTracer", [][]byte{[]byte("{}")})
+	}
+	if deleted := DeleteTraceResultsRange(db, 0, 4); deleted != 6 {
+		t.Fatalf("deleted entries mismatch: have %d, want 6", deleted)
+	}
+	// The entries below the start are not deleted
+	WriteTraceResults(db, 2, common.Hash{2}, "callTracer", [][]byte{[]byte("{}")})
+	if deleted := DeleteTraceResultsRange(db, 4, 8); deleted != 8 {
+		t.Fatalf("deleted entries mismatch: have %d, want 8", deleted)
+	}
+	for number := uint64(1); number <= 10; number++ {
+		results := ReadTraceResults(db, number, common.Hash{byte(number)}, "prestateTracer")
+		if number < 8 && results != nil {
+			t.Errorf("block %d: trace results not deleted", number)
+		}
+		if number >= 8 && len(results) != 1 {
+			t.Errorf("block %d: trace results mismatch: have %d, want 1", number, len(results))
+		}
+	}
+	if results := ReadTraceResults(db, 2, common.Hash{2}, "callTracer"); len(results) != 1 {
+		t.Errorf("trace results below the start deleted")
+	}
+}
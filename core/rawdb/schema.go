@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/metrics"
 )
 
@@ -78,6 +79,9 @@ var (
 	// storeInternalTxsEnabledKey flags that internal transactions will be stored into db
 	storeInternalTxsEnabledKey = []byte("storeInternalTxsEnabled")
 
+	// internalTxsBackfillKey tracks the progress of the internal transactions backfill
+	internalTxsBackfillKey = []byte("InternalTxsBackfill")
+
 	// lastFinalityVoteKey tracks the highest finality vote
 	highestFinalityVoteKey = []byte("HighestFinalityVote")
 
@@ -98,8 +102,14 @@ var (
 	SnapshotStoragePrefix = []byte("o") // SnapshotStoragePrefix + account hash + storage hash -> storage trie value
 	CodePrefix            = []byte("c") // CodePrefix + code hash -> account code
 
-	internalTxsPrefix = []byte("itxs") // internalTxsPrefix + block hash -> internal transactions
-	dirtyAccountsKey  = []byte("dacc") // dirtyAccountsPrefix + block hash -> dirty accounts
+	internalTxsPrefix       = []byte("itxs") // internalTxsPrefix + block hash -> internal transactions
+	internalTxAddressPrefix = []byte("itxa") // internalTxAddressPrefix + address + num (uint64 big endian) + block hash -> empty
+	dirtyAccountsKey        = []byte("dacc") // dirtyAccountsPrefix + block hash -> dirty accounts
+
+	doubleSignEvidencePrefix = []byte("dsev") // doubleSignEvidencePrefix + num (uint64 big endian) + hash1 + hash2 -> double sign evidence
+	signedVotePrefix         = []byte("svot") // signedVotePrefix + BLS public key -> latest signed vote of the key
+
+	traceResultsPrefix = []byte("trcr") // traceResultsPrefix + num (uint64 big endian) + hash + tracer -> trace results of the block
 
 	PreimagePrefix = []byte("secure-key-")      // PreimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
@@ -193,6 +203,29 @@ func internalTxsKey(hash common.Hash) []byte {
 	return append(internalTxsPrefix, hash.Bytes()...)
 }
 
+// internalTxAddressKey = internalTxAddressPrefix + address + num (uint64 big endian) + hash
+func internalTxAddressKey(address common.Address, number uint64, hash common.Hash) []byte {
+	key := append(append(internalTxAddressPrefix, address.Bytes()...), encodeBlockNumber(number)...)
+	return append(key, hash.Bytes()...)
+}
+
+// doubleSignEvidenceKey = doubleSignEvidencePrefix + num (uint64 big endian) + hash1 + hash2
+func doubleSignEvidenceKey(number uint64, hash1, hash2 common.Hash) []byte {
+	key := append(append(doubleSignEvidencePrefix, encodeBlockNumber(number)...), hash1.Bytes()...)
+	return append(key, hash2.Bytes()...)
+}
+
+// traceResultsKey = traceResultsPrefix + num (uint64 big endian) + hash + tracer
+func traceResultsKey(number uint64, hash common.Hash, tracer string) []byte {
+	key := append(append(traceResultsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+	return append(key, tracer...)
+}
+
+// signedVoteKey = signedVotePrefix + BLS public key
+func signedVoteKey(publicKey types.BLSPublicKey) []byte {
+	return append(signedVotePrefix, publicKey[:]...)
+}
+
 // txLookupKey = txLookupPrefix + hash
 func txLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
@@ -38,14 +38,29 @@ var (
 
 // IsWhitelistedDeployer reads the contract storage to check if an address is allow to deploy
 func IsWhitelistedDeployerV2(statedb *StateDB, address common.Address, blockTime uint64, whiteListContract *common.Address) bool {
-	contract := *whiteListContract
-	whitelistAllSlot := slotWhitelistDeployerMappingV2[WHITELIST_ALL]
-	whitelistAll := statedb.GetState(contract, GetLocSimpleVariable(whitelistAllSlot))
-
-	if whitelistAll.Big().Cmp(common.Big1) == 0 {
+	whitelistAll, activated, expiryTimestamp := GetWhitelistedDeployerInfoV2(statedb, address, whiteListContract)
+	if whitelistAll {
 		return true
 	}
 
+	// (whiteListInfo.activated && block.timestamp < whiteListInfo.expiryTimestamp)
+	// Compare expiredHash with Blockheader timestamp.
+	if activated {
+		if expiryTimestamp.Cmp(big.NewInt(int64(blockTime))) > 0 {
+			// Block time still is in expiredTime
+			return true
+		}
+	}
+	return false
+}
+
+// GetWhitelistedDeployerInfoV2 reads the contract storage to get whether all
+// addresses are allowed to deploy, and the whitelist info of the address.
+func GetWhitelistedDeployerInfoV2(statedb *StateDB, address common.Address, whiteListContract *common.Address) (whitelistAll bool, activated bool, expiryTimestamp *big.Int) {
+	contract := *whiteListContract
+	whitelistAllSlot := slotWhitelistDeployerMappingV2[WHITELIST_ALL]
+	whitelistAllHash := statedb.GetState(contract, GetLocSimpleVariable(whitelistAllSlot))
+
 	whitelistedSlot := slotWhitelistDeployerMappingV2[WHITELISTED]
 	// WhiteListInfo have 2 fields, so we need to plus 1.
 	// struct WhiteListInfo {
@@ -58,15 +73,7 @@ func IsWhitelistedDeployerV2(statedb *StateDB, address common.Address, blockTime
 
 	activatedHash := statedb.GetState(contract, activatedLoc)
 
-	// (whiteListInfo.activated && block.timestamp < whiteListInfo.expiryTimestamp)
-	// Compare expiredHash with Blockheader timestamp.
-	if activatedHash.Big().Cmp(common.Big1) == 0 {
-		if expiredHash.Big().Cmp(big.NewInt(int64(blockTime))) > 0 {
-			// Block time still is in expiredTime
-			return true
-		}
-	}
-	return false
+	return whitelistAllHash.Big().Cmp(common.Big1) == 0, activatedHash.Big().Cmp(common.Big1) == 0, expiredHash.Big()
 }
 
 // IsWhitelistedDeployer reads the contract storage to check if an address is allow to deploy
@@ -324,6 +324,9 @@ func (l *txList) Add(tx *types.Transaction, priceBump uint64) (bool, *types.Tran
 	if gas := tx.Gas(); l.gascap < gas {
 		l.gascap = gas
 	}
+	if old != nil {
+		l.removePayer(types.Transactions{old})
+	}
 	payer, err := types.Payer(l.signer, tx)
 	if err == nil {
 		l.payers[payer]++
@@ -456,7 +459,9 @@ func (l *txList) Remove(tx *types.Transaction) (bool, types.Transactions) {
 // prevent getting into and invalid state. This is not something that should ever
 // happen but better to be self correcting than failing!
 func (l *txList) Ready(start uint64) types.Transactions {
-	return l.txs.Ready(start)
+	ready := l.txs.Ready(start)
+	l.removePayer(ready)
+	return ready
 }
 
 // Len returns the length of the transaction list.
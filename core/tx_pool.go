@@ -18,6 +18,7 @@ package core
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sort"
@@ -94,6 +95,10 @@ var (
 
 	// ErrAddressBlacklisted is returned if a transaction is sent to blacklisted address
 	ErrAddressBlacklisted = errors.New("address is blacklisted")
+
+	// ErrPayerSlotsExceeded is returned if the payer of a sponsored transaction
+	// already pays for the maximum number of transactions allowed in the pool.
+	ErrPayerSlotsExceeded = errors.New("payer slots exceeded")
 )
 
 var (
@@ -115,6 +120,10 @@ var (
 	queuedNofundsMeter   = metrics.NewRegisteredMeter("txpool/queued/nofunds", nil)   // Dropped due to out-of-funds
 	queuedEvictionMeter  = metrics.NewRegisteredMeter("txpool/queued/eviction", nil)  // Dropped due to lifetime
 
+	// Metrics for the sponsored transactions
+	payerOverdraftMeter = metrics.NewRegisteredMeter("txpool/payer/overdraft", nil) // Dropped due to out-of-funds payer
+	payerExpiredMeter   = metrics.NewRegisteredMeter("txpool/payer/expired", nil)   // Dropped due to expired time
+
 	// General tx metrics
 	knownTxMeter       = metrics.NewRegisteredMeter("txpool/known", nil)
 	validTxMeter       = metrics.NewRegisteredMeter("txpool/valid", nil)
@@ -172,6 +181,7 @@ type TxPoolConfig struct {
 	GlobalSlots  uint64 // Maximum number of executable transaction slots for all accounts
 	AccountQueue uint64 // Maximum number of non-executable transaction slots permitted per account
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
+	PayerSlots   uint64 // Maximum number of sponsored transactions permitted per payer
 
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
 }
@@ -189,6 +199,7 @@ var DefaultTxPoolConfig = TxPoolConfig{
 	GlobalSlots:  4096 + 1024, // urgent + floating queue capacity with 4:1 ratio
 	AccountQueue: 64,
 	GlobalQueue:  1024,
+	PayerSlots:   256,
 
 	Lifetime: 3 * time.Hour,
 }
@@ -225,6 +236,10 @@ func (config *TxPoolConfig) sanitize() TxPoolConfig {
 		log.Warn("Sanitizing invalid txpool global queue", "provided", conf.GlobalQueue, "updated", DefaultTxPoolConfig.GlobalQueue)
 		conf.GlobalQueue = DefaultTxPoolConfig.GlobalQueue
 	}
+	if conf.PayerSlots < 1 {
+		log.Warn("Sanitizing invalid txpool payer slots", "provided", conf.PayerSlots, "updated", DefaultTxPoolConfig.PayerSlots)
+		conf.PayerSlots = DefaultTxPoolConfig.PayerSlots
+	}
 	if conf.Lifetime < 1 {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultTxPoolConfig.Lifetime)
 		conf.Lifetime = DefaultTxPoolConfig.Lifetime
@@ -302,7 +317,7 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		pending:         make(map[common.Address]*txList),
 		queue:           make(map[common.Address]*txList),
 		beats:           make(map[common.Address]time.Time),
-		all:             newTxLookup(),
+		all:             newTxLookup(types.LatestSigner(chainconfig)),
 		chainHeadCh:     make(chan ChainHeadEvent, chainHeadChanSize),
 		reqResetCh:      make(chan *txpoolResetRequest),
 		reqPromoteCh:    make(chan *accountSet),
@@ -408,6 +423,9 @@ func (pool *TxPool) loop() {
 					queuedEvictionMeter.Mark(int64(len(list)))
 				}
 			}
+			// Sponsored transactions expired by now can never be included
+			// in a future block, drop them even if they are locals
+			pool.evictExpiredSponsored(uint64(time.Now().Unix()))
 			pool.mu.Unlock()
 
 		// Handle local transaction journal rotation
@@ -539,6 +557,32 @@ func (pool *TxPool) ContentFrom(addr common.Address) (types.Transactions, types.
 	return pending, queued
 }
 
+// ContentFromPayer retrieves the data content of the transaction pool, returning
+// the pending as well as queued sponsored transactions paid by the given payer,
+// grouped by their senders and sorted by nonce.
+func (pool *TxPool) ContentFromPayer(payer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	pending := make(map[common.Address]types.Transactions)
+	queued := make(map[common.Address]types.Transactions)
+	for _, tx := range pool.all.PayerTxs(payer) {
+		from, _ := types.Sender(pool.signer, tx) // already validated during insertion
+		if list := pool.pending[from]; list != nil && list.txs.Get(tx.Nonce()) == tx {
+			pending[from] = append(pending[from], tx)
+		} else {
+			queued[from] = append(queued[from], tx)
+		}
+	}
+	for _, txs := range pending {
+		sort.Sort(types.TxByNonce(txs))
+	}
+	for _, txs := range queued {
+		sort.Sort(types.TxByNonce(txs))
+	}
+	return pending, queued
+}
+
 // Pending retrieves all currently processable transactions, grouped by origin
 // account and sorted by nonce. The returned transaction set is a copy and can be
 // freely modified by calling code.
@@ -681,10 +725,27 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 		}
 
 		// Ensure payer can pay for the gas fee == gas fee cap * gas limit
-		gasFee := new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas()))
+		gasFee := sponsoredGasFee(tx)
 		if pool.currentState.GetBalance(payer).Cmp(gasFee) < 0 {
 			return ErrInsufficientPayerFunds
 		}
+		// Ensure payer can also pay for the gas fee of all its other sponsored
+		// transactions in the pool, excluding the one to be replaced
+		replaced := pool.overlapped(from, tx.Nonce())
+		count, cost := 0, new(big.Int).Set(gasFee)
+		for _, ptx := range pool.all.PayerTxs(payer) {
+			if replaced != nil && ptx.Hash() == replaced.Hash() {
+				continue
+			}
+			count++
+			cost.Add(cost, sponsoredGasFee(ptx))
+		}
+		if pool.currentState.GetBalance(payer).Cmp(cost) < 0 {
+			return fmt.Errorf("%w: payer %s pays for %d transactions in pool", ErrInsufficientPayerFunds, payer.Hex(), count)
+		}
+		if uint64(count) >= pool.config.PayerSlots {
+			return fmt.Errorf("%w: payer %s pays for %d transactions in pool", ErrPayerSlotsExceeded, payer.Hex(), count)
+		}
 
 		// Ensure sender can pay for the value
 		if pool.currentState.GetBalance(from).Cmp(tx.Value()) < 0 {
@@ -720,17 +781,21 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 			whitelisted = state.IsWhitelistedDeployer(pool.currentState, from)
 		}
 		if !whitelisted {
-			return ErrUnauthorizedDeployer
+			return fmt.Errorf("%w: %s is not whitelisted to deploy contracts", ErrUnauthorizedDeployer, from.Hex())
 		}
 	}
 
 	// Check if sender and recipient are blacklisted
 	if pool.chainconfig.Consortium != nil && pool.odysseus {
 		contractAddr := pool.chainconfig.BlacklistContractAddress
-		if state.IsAddressBlacklisted(pool.currentState, contractAddr, &from) ||
-			state.IsAddressBlacklisted(pool.currentState, contractAddr, tx.To()) ||
-			state.IsAddressBlacklisted(pool.currentState, contractAddr, &payer) {
-			return ErrAddressBlacklisted
+		if state.IsAddressBlacklisted(pool.currentState, contractAddr, &from) {
+			return fmt.Errorf("%w: sender %s", ErrAddressBlacklisted, from.Hex())
+		}
+		if state.IsAddressBlacklisted(pool.currentState, contractAddr, tx.To()) {
+			return fmt.Errorf("%w: recipient %s", ErrAddressBlacklisted, tx.To().Hex())
+		}
+		if state.IsAddressBlacklisted(pool.currentState, contractAddr, &payer) {
+			return fmt.Errorf("%w: payer %s", ErrAddressBlacklisted, payer.Hex())
 		}
 	}
 
@@ -1133,6 +1198,85 @@ func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) {
 	}
 }
 
+// overlapped returns the pending or queued transaction of the given sender with
+// the given nonce, if any.
+func (pool *TxPool) overlapped(from common.Address, nonce uint64) *types.Transaction {
+	if list := pool.pending[from]; list != nil {
+		if tx := list.txs.Get(nonce); tx != nil {
+			return tx
+		}
+	}
+	if list := pool.queue[from]; list != nil {
+		return list.txs.Get(nonce)
+	}
+	return nil
+}
+
+// evictPayerOverdrafts removes the sponsored transactions of the payers whose
+// balance can no longer cover the gas fee of all their transactions in the
+// pool. The queued transactions are dropped first, then the ones with the
+// highest nonces, so that a sender's remaining transactions stay executable.
+func (pool *TxPool) evictPayerOverdrafts() {
+	for _, payer := range pool.all.Payers() {
+		var (
+			txs     = pool.all.PayerTxs(payer)
+			balance = pool.currentState.GetBalance(payer)
+			cost    = new(big.Int)
+		)
+		for _, tx := range txs {
+			cost.Add(cost, sponsoredGasFee(tx))
+		}
+		if cost.Cmp(balance) <= 0 {
+			continue
+		}
+		queued := make(map[common.Hash]bool, len(txs))
+		for _, tx := range txs {
+			from, _ := types.Sender(pool.signer, tx) // already validated during insertion
+			if list := pool.queue[from]; list != nil && list.txs.Get(tx.Nonce()) == tx {
+				queued[tx.Hash()] = true
+			}
+		}
+		sort.Slice(txs, func(i, j int) bool {
+			if qi, qj := queued[txs[i].Hash()], queued[txs[j].Hash()]; qi != qj {
+				return qi
+			}
+			return txs[i].Nonce() > txs[j].Nonce()
+		})
+		var dropped int
+		for _, tx := range txs {
+			if cost.Cmp(balance) <= 0 {
+				break
+			}
+			// The transaction might be already dropped along with the
+			// previous ones of the same sender
+			if pool.all.Get(tx.Hash()) == nil {
+				continue
+			}
+			cost.Sub(cost, sponsoredGasFee(tx))
+			pool.removeTx(tx.Hash(), true)
+			dropped++
+		}
+		log.Trace("Evicted sponsored transactions of overdrawn payer", "payer", payer, "count", dropped)
+		payerOverdraftMeter.Mark(int64(dropped))
+	}
+}
+
+// evictExpiredSponsored removes all the sponsored transactions which are
+// expired at the given time.
+func (pool *TxPool) evictExpiredSponsored(time uint64) {
+	expired := pool.all.SponsoredExpired(time)
+	for _, tx := range expired {
+		pool.removeTx(tx.Hash(), true)
+	}
+	payerExpiredMeter.Mark(int64(len(expired)))
+}
+
+// sponsoredGasFee returns the gas fee paid by the payer of a sponsored
+// transaction, gas fee cap * gas limit.
+func sponsoredGasFee(tx *types.Transaction) *big.Int {
+	return new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas()))
+}
+
 // requestReset requests a pool reset to the new head block.
 // The returned channel is closed when the reset has occurred.
 func (pool *TxPool) requestReset(oldHead *types.Header, newHead *types.Header) chan struct{} {
@@ -1275,6 +1419,7 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 	// because of another transaction (e.g. higher gas price).
 	if reset != nil {
 		pool.demoteUnexecutables()
+		pool.evictPayerOverdrafts()
 		if reset.newHead != nil {
 			if pool.chainconfig.IsLondon(new(big.Int).Add(reset.newHead.Number, big.NewInt(1))) {
 				// london fork enabled, reset given the base fee
@@ -1786,13 +1931,18 @@ type txLookup struct {
 	lock    sync.RWMutex
 	locals  map[common.Hash]*types.Transaction
 	remotes map[common.Hash]*types.Transaction
+
+	signer types.Signer                                          // Signer to derive the payers of sponsored transactions
+	payers map[common.Address]map[common.Hash]*types.Transaction // Sponsored transactions grouped by their payers
 }
 
 // newTxLookup returns a new txLookup structure.
-func newTxLookup() *txLookup {
+func newTxLookup(signer types.Signer) *txLookup {
 	return &txLookup{
 		locals:  make(map[common.Hash]*types.Transaction),
 		remotes: make(map[common.Hash]*types.Transaction),
+		signer:  signer,
+		payers:  make(map[common.Address]map[common.Hash]*types.Transaction),
 	}
 }
 
@@ -1891,6 +2041,12 @@ func (t *txLookup) Add(tx *types.Transaction, local bool) {
 	} else {
 		t.remotes[tx.Hash()] = tx
 	}
+	if payer, err := types.Payer(t.signer, tx); err == nil {
+		if t.payers[payer] == nil {
+			t.payers[payer] = make(map[common.Hash]*types.Transaction)
+		}
+		t.payers[payer][tx.Hash()] = tx
+	}
 }
 
 // Remove removes a transaction from the lookup.
@@ -1911,6 +2067,63 @@ func (t *txLookup) Remove(hash common.Hash) {
 
 	delete(t.locals, hash)
 	delete(t.remotes, hash)
+
+	if payer, err := types.Payer(t.signer, tx); err == nil {
+		delete(t.payers[payer], hash)
+		if len(t.payers[payer]) == 0 {
+			delete(t.payers, payer)
+		}
+	}
+}
+
+// Payers returns the payers of all the sponsored transactions in the lookup.
+func (t *txLookup) Payers() []common.Address {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	payers := make([]common.Address, 0, len(t.payers))
+	for payer := range t.payers {
+		payers = append(payers, payer)
+	}
+	return payers
+}
+
+// PayerTxs returns the sponsored transactions paid by the given payer.
+func (t *txLookup) PayerTxs(payer common.Address) types.Transactions {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	txs := make(types.Transactions, 0, len(t.payers[payer]))
+	for _, tx := range t.payers[payer] {
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+// PayerCount returns the number of sponsored transactions paid by the given
+// payer.
+func (t *txLookup) PayerCount(payer common.Address) int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return len(t.payers[payer])
+}
+
+// SponsoredExpired finds all sponsored transactions which are expired at the
+// given time.
+func (t *txLookup) SponsoredExpired(time uint64) types.Transactions {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	var found types.Transactions
+	for _, txs := range t.payers {
+		for _, tx := range txs {
+			if expiredTime := tx.ExpiredTime(); expiredTime != 0 && expiredTime <= time {
+				found = append(found, tx)
+			}
+		}
+	}
+	return found
 }
 
 // RemoteToLocals migrates the transactions belongs to the given locals to locals
@@ -24,6 +24,7 @@ import (
 	"math/big"
 	"math/rand"
 	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -2865,7 +2866,9 @@ func TestExpiredTimeAndGasCheckSponsoredTx(t *testing.T) {
 		t.Fatalf("Expect successfully add tx, get %s", err)
 	}
 
-	// 7. Sponsored tx with expired time == 0 is accepted
+	// 7. Sponsored tx with expired time == 0 is accepted, payer must be able
+	// to pay for both sponsored txs
+	statedb.SetBalance(crypto.PubkeyToAddress(payerKey.PublicKey), new(big.Int).Mul(big.NewInt(2*100000), big.NewInt(22000)))
 	innerTx.ExpiredTime = 0
 	innerTx.Nonce = 2
 	innerTx.PayerR, innerTx.PayerS, innerTx.PayerV, err = types.PayerSign(
@@ -2939,7 +2942,7 @@ func TestSponsoredTxInTxPoolQueue(t *testing.T) {
 		ExpiredTime: 100,
 	}
 	gasFee := new(big.Int).Mul(sponsoredTx1.GasFeeCap, new(big.Int).SetUint64(sponsoredTx1.Gas))
-	statedb.SetBalance(payerAddr, gasFee)
+	statedb.SetBalance(payerAddr, new(big.Int).Mul(gasFee, common.Big2))
 	statedb.SetBalance(senderAddr, sponsoredTx1.Value)
 
 	mikoSigner := types.NewMikoSigner(big.NewInt(2020))
@@ -2989,7 +2992,7 @@ func TestSponsoredTxInTxPoolQueue(t *testing.T) {
 	}
 
 	// 1. Payer fund is insufficient, 2 txs are removed from pending and queued
-	statedb.SubBalance(payerAddr, common.Big1)
+	statedb.SetBalance(payerAddr, new(big.Int).Sub(gasFee, common.Big1))
 	<-txpool.requestReset(nil, nil)
 	pending, queued = txpool.Stats()
 	if pending != 0 {
@@ -3000,7 +3003,7 @@ func TestSponsoredTxInTxPoolQueue(t *testing.T) {
 	}
 
 	// 2. Sender fund is insufficient, 2 txs are removed from pending and queued
-	statedb.AddBalance(payerAddr, common.Big1)
+	statedb.SetBalance(payerAddr, new(big.Int).Mul(gasFee, common.Big2))
 	errs = txpool.AddRemotesSync([]*types.Transaction{tx1, tx2})
 	for _, err := range errs {
 		if err != nil {
@@ -3086,7 +3089,7 @@ func TestSponsoredTxInTxPoolQueue(t *testing.T) {
 
 	// 4. Expired txs are removed from pending and queued
 	gasFee = new(big.Int).Mul(sponsoredTx1.GasFeeCap, new(big.Int).SetUint64(sponsoredTx1.Gas))
-	statedb.SetBalance(payerAddr, gasFee)
+	statedb.SetBalance(payerAddr, new(big.Int).Mul(gasFee, common.Big2))
 	errs = txpool.AddRemotesSync([]*types.Transaction{tx1, tx2})
 	for _, err := range errs {
 		if err != nil {
@@ -3149,3 +3152,160 @@ func TestSponsoredTxInTxPoolQueue(t *testing.T) {
 		t.Fatalf("Pending txpool, expect %d get %d", 1, pending)
 	}
 }
+
+func TestBlacklistedTxRejected(t *testing.T) {
+	var (
+		blacklistContract = common.HexToAddress("0x1000000000000000000000000000000000000002")
+		recipient         = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		chainConfig       = params.ChainConfig{
+			ChainID:                  big.NewInt(2020),
+			EIP155Block:              common.Big0,
+			OdysseusBlock:            common.Big0,
+			Consortium:               &params.ConsortiumConfig{EpochV2: 200},
+			BlacklistContractAddress: &blacklistContract,
+		}
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	// Blacklist the recipient in the blacklist contract storage
+	statedb.SetState(blacklistContract, state.GetLocMappingAtKey(recipient.Hash(), 1), common.BigToHash(common.Big1))
+	blockchain := &testBlockChain{10000000, statedb, new(event.Feed)}
+
+	txpool := NewTxPool(testTxPoolConfig, &chainConfig, blockchain)
+	defer txpool.Stop()
+
+	key, _ := crypto.GenerateKey()
+	testAddBalance(txpool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(params.Ether))
+
+	signer := types.LatestSigner(&chainConfig)
+	tx, _ := types.SignTx(types.NewTransaction(0, recipient, big.NewInt(1), params.TxGas, big.NewInt(1), nil), signer, key)
+	err := txpool.AddRemote(tx)
+	if !errors.Is(err, ErrAddressBlacklisted) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrAddressBlacklisted)
+	}
+	if !strings.Contains(err.Error(), "recipient "+recipient.Hex()) {
+		t.Errorf("error does not name the blacklisted recipient: %v", err)
+	}
+
+	other := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	tx, _ = types.SignTx(types.NewTransaction(0, other, big.NewInt(1), params.TxGas, big.NewInt(1), nil), signer, key)
+	if err := txpool.AddRemote(tx); err != nil {
+		t.Fatalf("failed to add transaction to non-blacklisted address: %v", err)
+	}
+}
+
+// sponsoredTransaction creates a sponsored transaction paid by the given payer.
+func sponsoredTransaction(t *testing.T, nonce uint64, gasPrice *big.Int, expiredTime uint64, senderKey, payerKey *ecdsa.PrivateKey) *types.Transaction {
+	recipient := common.HexToAddress("1000000000000000000000000000000000000001")
+	innerTx := types.SponsoredTx{
+		ChainID:     big.NewInt(2020),
+		Nonce:       nonce,
+		GasTipCap:   gasPrice,
+		GasFeeCap:   gasPrice,
+		Gas:         21000,
+		To:          &recipient,
+		ExpiredTime: expiredTime,
+	}
+	signer := types.NewMikoSigner(big.NewInt(2020))
+	var err error
+	innerTx.PayerR, innerTx.PayerS, innerTx.PayerV, err = types.PayerSign(payerKey, signer, crypto.PubkeyToAddress(senderKey.PublicKey), &innerTx)
+	if err != nil {
+		t.Fatalf("Payer fails to sign transaction, err %s", err)
+	}
+	tx, err := types.SignNewTx(senderKey, signer, &innerTx)
+	if err != nil {
+		t.Fatalf("Fail to sign transaction, err %s", err)
+	}
+	return tx
+}
+
+// Tests that the gas fee of all the sponsored transactions of a payer is
+// accounted against its balance, across all the senders.
+func TestSponsoredTxPayerAccounting(t *testing.T) {
+	var chainConfig params.ChainConfig
+
+	chainConfig.EIP155Block = common.Big0
+	chainConfig.MikoBlock = common.Big0
+	chainConfig.ChainID = big.NewInt(2020)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{10000000, statedb, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.PayerSlots = 3
+	txpool := NewTxPool(config, &chainConfig, blockchain)
+	defer txpool.Stop()
+
+	sender1, _ := crypto.GenerateKey()
+	sender2, _ := crypto.GenerateKey()
+	payer, _ := crypto.GenerateKey()
+	payerAddr := crypto.PubkeyToAddress(payer.PublicKey)
+
+	price := big.NewInt(100000)
+	gasFee := new(big.Int).Mul(price, big.NewInt(21000))
+	statedb.SetBalance(payerAddr, new(big.Int).Mul(gasFee, big.NewInt(2)))
+
+	// The payer can pay for 2 transactions of different senders, not a third one
+	tx1 := sponsoredTransaction(t, 0, price, 0, sender1, payer)
+	tx2 := sponsoredTransaction(t, 0, price, 0, sender2, payer)
+	for _, err := range txpool.AddRemotesSync([]*types.Transaction{tx1, tx2}) {
+		if err != nil {
+			t.Fatalf("Fail to add tx to pool, err %s", err)
+		}
+	}
+	tx3 := sponsoredTransaction(t, 1, price, 0, sender1, payer)
+	if err := txpool.addRemoteSync(tx3); !errors.Is(err, ErrInsufficientPayerFunds) {
+		t.Fatalf("Expect error %s, get %v", ErrInsufficientPayerFunds, err)
+	}
+	// Replacing a transaction only accounts for the new gas fee
+	statedb.SetBalance(payerAddr, new(big.Int).Add(new(big.Int).Mul(gasFee, big.NewInt(2)), new(big.Int).Div(gasFee, big.NewInt(10))))
+	tx1 = sponsoredTransaction(t, 0, big.NewInt(110000), 0, sender1, payer)
+	if err := txpool.addRemoteSync(tx1); err != nil {
+		t.Fatalf("Fail to replace tx, err %s", err)
+	}
+	// The payer slots are limited
+	statedb.SetBalance(payerAddr, new(big.Int).Mul(gasFee, big.NewInt(10)))
+	if err := txpool.addRemoteSync(tx3); err != nil {
+		t.Fatalf("Fail to add tx to pool, err %s", err)
+	}
+	tx4 := sponsoredTransaction(t, 2, price, 0, sender1, payer)
+	if err := txpool.addRemoteSync(tx4); !errors.Is(err, ErrPayerSlotsExceeded) {
+		t.Fatalf("Expect error %s, get %v", ErrPayerSlotsExceeded, err)
+	}
+	pending, queued := txpool.ContentFromPayer(payerAddr)
+	if len(pending) != 2 || len(pending[crypto.PubkeyToAddress(sender1.PublicKey)]) != 2 || len(queued) != 0 {
+		t.Fatalf("Payer content mismatch: pending %v, queued %v", pending, queued)
+	}
+	if err := validateTxPoolInternals(txpool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// The transactions with the highest nonces are evicted once the payer
+	// can no longer pay for all of them
+	statedb.SetBalance(payerAddr, new(big.Int).Add(sponsoredGasFee(tx1), sponsoredGasFee(tx2)))
+	<-txpool.requestReset(nil, nil)
+	if txpool.Get(tx3.Hash()) != nil {
+		t.Fatal("Overdrawn payer tx is not evicted")
+	}
+	if txpool.Get(tx1.Hash()) == nil || txpool.Get(tx2.Hash()) == nil {
+		t.Fatal("Executable payer txs are evicted")
+	}
+	if count := txpool.all.PayerCount(payerAddr); count != 2 {
+		t.Fatalf("Payer tx count mismatch: have %d, want %d", count, 2)
+	}
+	if err := validateTxPoolInternals(txpool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// Expired sponsored transactions are evicted
+	statedb.SetBalance(payerAddr, new(big.Int).Mul(gasFee, big.NewInt(10)))
+	if err := txpool.addRemoteSync(sponsoredTransaction(t, 1, price, 1000, sender2, payer)); err != nil {
+		t.Fatalf("Fail to add tx to pool, err %s", err)
+	}
+	txpool.mu.Lock()
+	txpool.evictExpiredSponsored(1000)
+	txpool.mu.Unlock()
+	if count := txpool.all.PayerCount(payerAddr); count != 2 {
+		t.Fatalf("Payer tx count mismatch: have %d, want %d", count, 2)
+	}
+	if err := validateTxPoolInternals(txpool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DoubleSignEvidence is a pair of different headers at the same height which
+// are sealed by the same signer.
+type DoubleSignEvidence struct {
+	Signer  common.Address `json:"signer"`
+	Header1 *Header        `json:"header1"`
+	Header2 *Header        `json:"header2"`
+}
+
+// Number returns the block number of the conflicting headers.
+func (evidence *DoubleSignEvidence) Number() uint64 {
+	return evidence.Header1.Number.Uint64()
+}
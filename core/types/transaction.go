@@ -699,8 +699,13 @@ func copyAddressPtr(a *common.Address) *common.Address {
 const (
 	InternalTransactionContractCall     = "call"
 	InternalTransactionContractCreation = "create"
+	InternalTransactionSelfDestruct     = "selfdestruct"
 )
 
+// InternalTransactionVersion1 is the version of the internal transactions
+// recording the call depth, gas and the parent internal transaction.
+const InternalTransactionVersion1 = 1
+
 type InternalTransaction struct {
 	Opcode  string
 	Type    string
@@ -708,6 +713,15 @@ type InternalTransaction struct {
 	Error   string
 	Output  []byte
 	*InternalTransactionBody
+
+	// The fields below are appended in InternalTransactionVersion1, they are
+	// optional so that the internal transactions stored before are still
+	// decodable, as version 0 with the fields left empty.
+	Version     uint64 `rlp:"optional"`
+	Depth       uint64 `rlp:"optional"` // Call depth, 1 for the calls made by the transaction itself
+	ParentOrder uint64 `rlp:"optional"` // Order of the parent internal transaction, 0 if none
+	Gas         uint64 `rlp:"optional"` // Gas provided to the call
+	GasUsed     uint64 `rlp:"optional"` // Gas used by the call
 }
 
 type InternalTransactionBody struct {
@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 var (
@@ -119,9 +120,11 @@ func SignNewTx(prv *ecdsa.PrivateKey, s Signer, txdata TxData) (*Transaction, er
 	return tx.WithSignature(s, sig)
 }
 
-func PayerSign(prv *ecdsa.PrivateKey, signer Signer, sender common.Address, txdata TxData) (r, s, v *big.Int, err error) {
-	payerHash := rlpHash([]interface{}{
-		signer.ChainID(),
+// payerSigningFields returns the fields of sponsored transaction that are
+// signed by the payer.
+func payerSigningFields(chainID *big.Int, sender common.Address, txdata TxData) []interface{} {
+	return []interface{}{
+		chainID,
 		sender,
 		txdata.nonce(),
 		txdata.gasTipCap(),
@@ -131,7 +134,20 @@ func PayerSign(prv *ecdsa.PrivateKey, signer Signer, sender common.Address, txda
 		txdata.value(),
 		txdata.data(),
 		txdata.expiredTime(),
-	})
+	}
+}
+
+// PayerSigningData returns the RLP encoded data whose keccak256 hash is signed
+// by the payer of the sponsored transaction sent by sender.
+func PayerSigningData(chainID *big.Int, sender common.Address, tx *Transaction) ([]byte, error) {
+	if tx.Type() != SponsoredTxType {
+		return nil, ErrInvalidTxType
+	}
+	return rlp.EncodeToBytes(payerSigningFields(chainID, sender, tx.inner))
+}
+
+func PayerSign(prv *ecdsa.PrivateKey, signer Signer, sender common.Address, txdata TxData) (r, s, v *big.Int, err error) {
+	payerHash := rlpHash(payerSigningFields(signer.ChainID(), sender, txdata))
 
 	sig, err := crypto.Sign(payerHash[:], prv)
 	if err != nil {
@@ -491,18 +507,8 @@ func payerInternal(s Signer, tx *Transaction) (common.Address, error) {
 	}
 
 	payerV, payerR, payerS := tx.RawPayerSignatureValues()
-	payerHash := rlpHash([]interface{}{
-		tx.ChainId(), // The chainId is checked in Sender already
-		sender,
-		tx.Nonce(),
-		tx.GasTipCap(),
-		tx.GasFeeCap(),
-		tx.Gas(),
-		tx.To(),
-		tx.Value(),
-		tx.Data(),
-		tx.ExpiredTime(),
-	})
+	// The chainId is checked in Sender already
+	payerHash := rlpHash(payerSigningFields(tx.ChainId(), sender, tx.inner))
 
 	// V in payer signature is {0, 1}, but the recoverPlain expects
 	// {0, 1} + 27, so we need to add 27 to V
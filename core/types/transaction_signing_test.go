@@ -298,3 +298,68 @@ func TestSponsoredTransactionSigner(t *testing.T) {
 		t.Fatalf("Expect %s, get %s", ErrInvalidChainId, err)
 	}
 }
+
+func TestPayerSigningData(t *testing.T) {
+	sender, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	senderAddr := crypto.PubkeyToAddress(sender.PublicKey)
+
+	payer, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	payerAddr := crypto.PubkeyToAddress(payer.PublicKey)
+
+	chainID := big.NewInt(2020)
+	signer := NewMikoSigner(chainID)
+	innerTx := SponsoredTx{
+		ChainID:     chainID,
+		Nonce:       1,
+		GasTipCap:   big.NewInt(100000),
+		GasFeeCap:   big.NewInt(100000),
+		Gas:         1000,
+		Value:       big.NewInt(10),
+		Data:        []byte("abcd"),
+		ExpiredTime: 100000,
+		PayerV:      new(big.Int),
+		PayerR:      new(big.Int),
+		PayerS:      new(big.Int),
+	}
+
+	// Sign the payer data like a wallet does and compare with PayerSign
+	data, err := PayerSigningData(chainID, senderAddr, NewTx(&innerTx))
+	if err != nil {
+		t.Fatalf("Failed to get payer signing data, err %s", err)
+	}
+	sig, err := crypto.Sign(crypto.Keccak256(data), payer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, s, v, err := PayerSign(payer, signer, senderAddr, &innerTx)
+	if err != nil {
+		t.Fatalf("Payer fails to sign, err %s", err)
+	}
+	if new(big.Int).SetBytes(sig[:32]).Cmp(r) != 0 || new(big.Int).SetBytes(sig[32:64]).Cmp(s) != 0 ||
+		uint64(sig[64]) != v.Uint64() {
+		t.Fatalf("Payer signature mismatches")
+	}
+
+	innerTx.PayerR, innerTx.PayerS, innerTx.PayerV = r, s, v
+	tx, err := SignTx(NewTx(&innerTx), signer, sender)
+	if err != nil {
+		t.Fatalf("Failed to sign tx, err %s", err)
+	}
+	recoveredPayerAddr, err := Payer(signer, tx)
+	if err != nil {
+		t.Fatalf("Failed to recover payer, err %s", err)
+	}
+	if recoveredPayerAddr != payerAddr {
+		t.Fatalf("Payer mismatches, get %s expect %s", recoveredPayerAddr, payerAddr)
+	}
+
+	if _, err := PayerSigningData(chainID, senderAddr, NewTx(&LegacyTx{})); !errors.Is(err, ErrInvalidTxType) {
+		t.Fatalf("Expect %s, get %s", ErrInvalidTxType, err)
+	}
+}
@@ -31,6 +31,14 @@ type RawVoteEnvelope struct {
 	Data      *VoteData    // The vote data for fast finality.
 }
 
+// SignedVoteRecord is the latest vote signed by a BLS key, it is used to
+// prevent the key from signing 2 different votes at the same height.
+type SignedVoteRecord struct {
+	PublicKey    BLSPublicKey
+	TargetNumber uint64
+	SigningRoot  common.Hash // The hash of the signed vote data
+}
+
 // VoteEnvelope represents the vote of a single validator.
 type VoteEnvelope struct {
 	RawVoteEnvelope
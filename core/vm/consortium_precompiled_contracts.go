@@ -516,23 +516,7 @@ func (c *consortiumVerifyHeaders) verify(consensusAddr common.Address, header1,
 	if c.evm != nil && !c.evm.chainConfig.IsConsortiumV2(header1.Number) {
 		return false
 	}
-	if header1.ToHeader().ParentHash.Hex() != header2.ToHeader().ParentHash.Hex() {
-		return false
-	}
-	if len(header1.ExtraData) < crypto.SignatureLength || len(header2.ExtraData) < crypto.SignatureLength {
-		return false
-	}
-	if bytes.Equal(SealHash(header1.ToHeader(), header1.ChainId).Bytes(), SealHash(header2.ToHeader(), header2.ChainId).Bytes()) {
-		return false
-	}
-	signer1, err := c.getSigner(header1)
-	if err != nil {
-		log.Trace("[consortiumVerifyHeaders][verify] error while getting signer from header1", "err", err)
-		return false
-	}
-	signer2, err := c.getSigner(header2)
-	if err != nil {
-		log.Trace("[consortiumVerifyHeaders][verify] error while getting signer from header2", "err", err)
+	if !c.verifySignedHeaders(consensusAddr, header1, header2) {
 		return false
 	}
 	if unmarshalledABIs[GetDoubleSignSlashingConfig] == nil {
@@ -574,11 +558,63 @@ func (c *consortiumVerifyHeaders) verify(consensusAddr common.Address, header1,
 		}
 	}
 
+	return true
+}
+
+// verifySignedHeaders checks that 2 different headers at the same height are
+// both signed by consensusAddr, these checks do not depend on the chain state
+func (c *consortiumVerifyHeaders) verifySignedHeaders(consensusAddr common.Address, header1, header2 types.BlockHeader) bool {
+	if header1.ToHeader().ParentHash.Hex() != header2.ToHeader().ParentHash.Hex() {
+		return false
+	}
+	if len(header1.ExtraData) < crypto.SignatureLength || len(header2.ExtraData) < crypto.SignatureLength {
+		return false
+	}
+	if bytes.Equal(SealHash(header1.ToHeader(), header1.ChainId).Bytes(), SealHash(header2.ToHeader(), header2.ChainId).Bytes()) {
+		return false
+	}
+	signer1, err := c.getSigner(header1)
+	if err != nil {
+		log.Trace("[consortiumVerifyHeaders][verify] error while getting signer from header1", "err", err)
+		return false
+	}
+	signer2, err := c.getSigner(header2)
+	if err != nil {
+		log.Trace("[consortiumVerifyHeaders][verify] error while getting signer from header2", "err", err)
+		return false
+	}
+
 	return signer1.Hex() == signer2.Hex() &&
 		signer2.Hex() == header2.Benificiary.Hex() &&
 		bytes.Equal(consensusAddr.Bytes(), signer1.Bytes())
 }
 
+// EncodeDoubleSignHeader encodes the header in the format that
+// validatingDoubleSignProof precompile accepts.
+func EncodeDoubleSignHeader(header *types.Header, chainId *big.Int) ([]byte, error) {
+	return types.FromHeader(header, chainId).Bytes(rawConsortiumVerifyHeadersAbi, getHeader)
+}
+
+// ValidateDoubleSignProof checks the encoded headers with the same rules as
+// validatingDoubleSignProof precompile, except the rules that require the chain
+// state (the fork activation and the maximum offset of the evidence).
+func ValidateDoubleSignProof(consensusAddr common.Address, rawHeader1, rawHeader2 []byte) bool {
+	if unmarshalledABIs[VerifyHeaders] == nil {
+		return false
+	}
+	smcAbi := *unmarshalledABIs[VerifyHeaders]
+
+	c := &consortiumVerifyHeaders{}
+	var blockHeader1, blockHeader2 types.BlockHeader
+	if err := c.unpack(smcAbi, &blockHeader1, rawHeader1); err != nil {
+		return false
+	}
+	if err := c.unpack(smcAbi, &blockHeader2, rawHeader2); err != nil {
+		return false
+	}
+	return c.verifySignedHeaders(consensusAddr, blockHeader1, blockHeader2)
+}
+
 // SealHash returns the hash of a block prior to it being sealed.
 func SealHash(header *types.Header, chainId *big.Int) (hash common.Hash) {
 	hasher := sha3.NewLegacyKeccak256()
@@ -35,7 +35,7 @@ import (
 var emptyCodeHash = crypto.Keccak256Hash(nil)
 
 type OpEvent interface {
-	Publish(op OpCode, order, blockHeight uint64, blockHash common.Hash, timestamp uint64, txHash common.Hash, from, to common.Address, value *big.Int, input, output []byte, err error) *types.InternalTransaction
+	Publish(op OpCode, order, blockHeight uint64, blockHash common.Hash, timestamp uint64, txHash common.Hash, from, to common.Address, value *big.Int, input, output []byte, depth, parentOrder, gas, gasUsed uint64, err error) *types.InternalTransaction
 }
 
 type (
@@ -173,6 +173,10 @@ type EVM struct {
 	// available gas is calculated in gasCall* according to the 63/64 rule and later
 	// applied in opCall*.
 	callGasTemp uint64
+	// internalTxOrders holds the orders of the internal transactions being
+	// executed, the last one is the parent of the internal transactions made
+	// by the current call frame.
+	internalTxOrders []uint64
 
 	evmHook EVMHook
 }
@@ -658,6 +662,22 @@ func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, endowment *
 // ChainConfig returns the environment's chain configuration
 func (evm *EVM) ChainConfig() *params.ChainConfig { return evm.chainConfig }
 
+// enterInternalTx marks the internal transaction with the given order as the
+// parent of the internal transactions made until exitInternalTx is called.
+func (evm *EVM) enterInternalTx(order uint64) {
+	if len(evm.Context.PublishEvents) > 0 {
+		evm.internalTxOrders = append(evm.internalTxOrders, order)
+	}
+}
+
+// exitInternalTx restores the parent internal transaction when a call frame
+// is finished.
+func (evm *EVM) exitInternalTx() {
+	if n := len(evm.internalTxOrders); n > 0 && len(evm.Context.PublishEvents) > 0 {
+		evm.internalTxOrders = evm.internalTxOrders[:n-1]
+	}
+}
+
 // PublishEvent executes Publish function from OpEvent if OpCode is found in Context.PublishEvents
 func (evm *EVM) PublishEvent(
 	opCode OpCode,
@@ -665,6 +685,7 @@ func (evm *EVM) PublishEvent(
 	from, to common.Address,
 	value *big.Int,
 	input, output []byte,
+	gas, gasUsed uint64,
 	err error,
 ) {
 	context := evm.Context
@@ -676,6 +697,12 @@ func (evm *EVM) PublishEvent(
 	txHash := context.CurrentTransaction.Hash()
 	log.Debug("[EVM] PublishEvent", "transaction", txHash.Hex(), "opCode", opCode.String(), "from", from.Hash().Hex())
 	if event, ok := evm.Context.PublishEvents[opCode]; ok {
+		// The order of the parent internal transaction, 0 means the internal
+		// transaction is made by the transaction itself.
+		var parentOrder uint64
+		if n := len(evm.internalTxOrders); n > 0 {
+			parentOrder = evm.internalTxOrders[n-1]
+		}
 		*context.InternalTransactions = append(
 			*context.InternalTransactions,
 			event.Publish(
@@ -690,6 +717,10 @@ func (evm *EVM) PublishEvent(
 				value,
 				input,
 				output,
+				uint64(evm.depth),
+				parentOrder,
+				gas,
+				gasUsed,
 				err,
 			),
 		)
@@ -20,6 +20,7 @@ func (tx *TestOpEvent) Publish(
 	from, to common.Address,
 	value *big.Int,
 	input, output []byte,
+	depth, parentOrder, gas, gasUsed uint64,
 	err error,
 ) *types.InternalTransaction {
 	return &types.InternalTransaction{
@@ -61,7 +62,7 @@ func TestPublishEvents(t *testing.T) {
 	}
 
 	evm := &EVM{Context: ctx}
-	evm.PublishEvent(CALL, 1, common.Address{}, common.Address{}, big.NewInt(0), []byte(""), []byte(""), nil)
+	evm.PublishEvent(CALL, 1, common.Address{}, common.Address{}, big.NewInt(0), []byte(""), []byte(""), 0, 0, nil)
 	if len(*evm.Context.InternalTransactions) != 1 || (*evm.Context.InternalTransactions)[0].Type != "test" {
 		t.Error("Failed to publish opcode event")
 	}
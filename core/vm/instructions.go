@@ -581,7 +581,9 @@ func opCreate(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]b
 		bigVal = value.ToBig()
 	}
 	counter := interpreter.evm.Context.Counter
+	interpreter.evm.enterInternalTx(counter)
 	res, addr, returnGas, suberr := interpreter.evm.Create(scope.Contract, input, gas, bigVal)
+	interpreter.evm.exitInternalTx()
 	// Push item on the stack based on the returned error. If the ruleset is
 	// homestead we must check for CodeStoreOutOfGasError (homestead only
 	// rule) and treat as an error, if the ruleset is frontier we must
@@ -597,7 +599,7 @@ func opCreate(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]b
 	scope.Contract.Gas += returnGas
 
 	// call publish event to publish CREATE event
-	interpreter.evm.PublishEvent(CREATE, counter, scope.Contract.Address(), addr, bigVal, input, res, suberr)
+	interpreter.evm.PublishEvent(CREATE, counter, scope.Contract.Address(), addr, bigVal, input, res, gas, gas-returnGas, suberr)
 
 	if suberr == ErrExecutionReverted {
 		return res, nil
@@ -625,8 +627,10 @@ func opCreate2(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]
 		bigEndowment = endowment.ToBig()
 	}
 	counter := interpreter.evm.Context.Counter
+	interpreter.evm.enterInternalTx(counter)
 	res, addr, returnGas, suberr := interpreter.evm.Create2(scope.Contract, input, gas,
 		bigEndowment, &salt)
+	interpreter.evm.exitInternalTx()
 	// Push item on the stack based on the returned error.
 	if suberr != nil {
 		stackvalue.Clear()
@@ -637,7 +641,7 @@ func opCreate2(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]
 	scope.Contract.Gas += returnGas
 
 	// call publish event to publish CREATE2 event
-	interpreter.evm.PublishEvent(CREATE2, counter, scope.Contract.Address(), addr, bigEndowment, input, res, suberr)
+	interpreter.evm.PublishEvent(CREATE2, counter, scope.Contract.Address(), addr, bigEndowment, input, res, gas, gas-returnGas, suberr)
 
 	if suberr == ErrExecutionReverted {
 		return res, nil
@@ -668,7 +672,9 @@ func opCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byt
 		bigVal = value.ToBig()
 	}
 	counter := interpreter.evm.Context.Counter
+	interpreter.evm.enterInternalTx(counter)
 	ret, returnGas, err := interpreter.evm.Call(scope.Contract, toAddr, args, gas, bigVal)
+	interpreter.evm.exitInternalTx()
 
 	if err != nil {
 		temp.Clear()
@@ -682,7 +688,7 @@ func opCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byt
 	}
 	scope.Contract.Gas += returnGas
 	// call publish event to publish CALL event
-	interpreter.evm.PublishEvent(CALL, counter, scope.Contract.Address(), toAddr, bigVal, cpyArgs, ret, err)
+	interpreter.evm.PublishEvent(CALL, counter, scope.Contract.Address(), toAddr, bigVal, cpyArgs, ret, gas, gas-returnGas, err)
 	return ret, nil
 }
 
@@ -697,6 +703,8 @@ func opCallCode(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([
 	toAddr := common.Address(addr.Bytes20())
 	// Get arguments from the memory.
 	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	cpyArgs := make([]byte, len(args))
+	copy(cpyArgs, args)
 
 	//TODO: use uint256.Int instead of converting with toBig()
 	var bigVal = big0
@@ -704,8 +712,11 @@ func opCallCode(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([
 		gas += params.CallStipend
 		bigVal = value.ToBig()
 	}
+	counter := interpreter.evm.Context.Counter
 
+	interpreter.evm.enterInternalTx(counter)
 	ret, returnGas, err := interpreter.evm.CallCode(scope.Contract, toAddr, args, gas, bigVal)
+	interpreter.evm.exitInternalTx()
 	if err != nil {
 		temp.Clear()
 	} else {
@@ -717,6 +728,8 @@ func opCallCode(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([
 		scope.Memory.Set(retOffset.Uint64(), retSize.Uint64(), ret)
 	}
 	scope.Contract.Gas += returnGas
+	// call publish event to publish CALLCODE event
+	interpreter.evm.PublishEvent(CALLCODE, counter, scope.Contract.Address(), toAddr, bigVal, cpyArgs, ret, gas, gas-returnGas, err)
 
 	return ret, nil
 }
@@ -736,7 +749,9 @@ func opDelegateCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext
 	copy(cpyArgs, args)
 	counter := interpreter.evm.Context.Counter
 
+	interpreter.evm.enterInternalTx(counter)
 	ret, returnGas, err := interpreter.evm.DelegateCall(scope.Contract, toAddr, args, gas)
+	interpreter.evm.exitInternalTx()
 	if err != nil {
 		temp.Clear()
 	} else {
@@ -749,7 +764,7 @@ func opDelegateCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext
 	}
 	scope.Contract.Gas += returnGas
 	// call publish event to publish CALL event
-	interpreter.evm.PublishEvent(DELEGATECALL, counter, scope.Contract.Address(), toAddr, scope.Contract.Value(), cpyArgs, ret, err)
+	interpreter.evm.PublishEvent(DELEGATECALL, counter, scope.Contract.Address(), toAddr, scope.Contract.Value(), cpyArgs, ret, gas, gas-returnGas, err)
 
 	return ret, nil
 }
@@ -765,8 +780,13 @@ func opStaticCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext)
 	toAddr := common.Address(addr.Bytes20())
 	// Get arguments from the memory.
 	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	cpyArgs := make([]byte, len(args))
+	copy(cpyArgs, args)
+	counter := interpreter.evm.Context.Counter
 
+	interpreter.evm.enterInternalTx(counter)
 	ret, returnGas, err := interpreter.evm.StaticCall(scope.Contract, toAddr, args, gas)
+	interpreter.evm.exitInternalTx()
 	if err != nil {
 		temp.Clear()
 	} else {
@@ -778,6 +798,8 @@ func opStaticCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext)
 		scope.Memory.Set(retOffset.Uint64(), retSize.Uint64(), ret)
 	}
 	scope.Contract.Gas += returnGas
+	// call publish event to publish STATICCALL event
+	interpreter.evm.PublishEvent(STATICCALL, counter, scope.Contract.Address(), toAddr, big0, cpyArgs, ret, gas, gas-returnGas, err)
 
 	return ret, nil
 }
@@ -809,6 +831,8 @@ func opSuicide(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]
 		interpreter.cfg.Tracer.CaptureEnter(SELFDESTRUCT, scope.Contract.Address(), beneficiary.Bytes20(), []byte{}, 0, balance)
 		interpreter.cfg.Tracer.CaptureExit([]byte{}, 0, nil)
 	}
+	// call publish event to publish the balance transfer of SELFDESTRUCT
+	interpreter.evm.PublishEvent(SELFDESTRUCT, interpreter.evm.Context.Counter, scope.Contract.Address(), beneficiary.Bytes20(), balance, nil, nil, 0, 0, nil)
 	return nil, nil
 }
 
@@ -0,0 +1,183 @@
+package vote
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// InterchangeFormatVersion is the version of EIP-3076 slashing protection
+// interchange format that is supported.
+const InterchangeFormatVersion = "5"
+
+var (
+	errStaleVote            = errors.New("vote is lower than the latest signed vote")
+	errDoubleVote           = errors.New("a different vote is already signed at the same height")
+	errInterchangeVersion   = errors.New("unsupported interchange format version")
+	errInterchangeGenesis   = errors.New("interchange genesis mismatches")
+	errInterchangePublicKey = errors.New("invalid BLS public key in interchange")
+)
+
+// SlashingProtection keeps track of the latest vote signed by each BLS key and
+// refuses to sign the vote that may get the validator slashed. Only the latest
+// vote is kept as the validator must vote for strictly increasing heights.
+type SlashingProtection struct {
+	db ethdb.KeyValueStore
+	mu sync.Mutex
+}
+
+func NewSlashingProtection(db ethdb.KeyValueStore) *SlashingProtection {
+	return &SlashingProtection{db: db}
+}
+
+// CheckAndRecord checks if the vote data is safe to be signed by the key and
+// records it as the latest signed vote. It must be called before the vote is
+// signed. Signing the same vote data again is allowed.
+func (protection *SlashingProtection) CheckAndRecord(publicKey types.BLSPublicKey, data *types.VoteData) error {
+	protection.mu.Lock()
+	defer protection.mu.Unlock()
+
+	signingRoot := data.Hash()
+	if record := rawdb.ReadSignedVoteRecord(protection.db, publicKey); record != nil {
+		if data.TargetNumber < record.TargetNumber {
+			return errStaleVote
+		}
+		if data.TargetNumber == record.TargetNumber {
+			if signingRoot != record.SigningRoot {
+				return errDoubleVote
+			}
+			return nil
+		}
+	}
+
+	rawdb.WriteSignedVoteRecord(protection.db, &types.SignedVoteRecord{
+		PublicKey:    publicKey,
+		TargetNumber: data.TargetNumber,
+		SigningRoot:  signingRoot,
+	})
+	return nil
+}
+
+// Interchange is the EIP-3076 slashing protection interchange data. The finality
+// votes are stored as signed blocks whose slot is the target block number.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeData   `json:"data"`
+}
+
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string      `json:"interchange_format_version"`
+	GenesisValidatorsRoot    common.Hash `json:"genesis_validators_root"`
+}
+
+type InterchangeData struct {
+	PublicKey          hexutil.Bytes                  `json:"pubkey"`
+	SignedBlocks       []InterchangeSignedBlock       `json:"signed_blocks"`
+	SignedAttestations []InterchangeSignedAttestation `json:"signed_attestations"`
+}
+
+type InterchangeSignedBlock struct {
+	Slot        uint64       `json:"slot,string"`
+	SigningRoot *common.Hash `json:"signing_root,omitempty"`
+}
+
+type InterchangeSignedAttestation struct {
+	SourceEpoch uint64       `json:"source_epoch,string"`
+	TargetEpoch uint64       `json:"target_epoch,string"`
+	SigningRoot *common.Hash `json:"signing_root,omitempty"`
+}
+
+// Export returns the latest signed votes in the interchange format, genesis is
+// the genesis block hash of the chain.
+func (protection *SlashingProtection) Export(genesis common.Hash) *Interchange {
+	protection.mu.Lock()
+	defer protection.mu.Unlock()
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    genesis,
+		},
+		Data: []InterchangeData{},
+	}
+	for _, record := range rawdb.ReadAllSignedVoteRecords(protection.db) {
+		signingRoot := record.SigningRoot
+		interchange.Data = append(interchange.Data, InterchangeData{
+			PublicKey: common.CopyBytes(record.PublicKey[:]),
+			SignedBlocks: []InterchangeSignedBlock{
+				{Slot: record.TargetNumber, SigningRoot: &signingRoot},
+			},
+			SignedAttestations: []InterchangeSignedAttestation{},
+		})
+	}
+	return interchange
+}
+
+// Import merges the interchange data into the database, the latest signed vote
+// of each key is the highest one among the database and the interchange.
+func (protection *SlashingProtection) Import(interchange *Interchange, genesis common.Hash) error {
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return fmt.Errorf("%w: %s", errInterchangeVersion, interchange.Metadata.InterchangeFormatVersion)
+	}
+	if interchange.Metadata.GenesisValidatorsRoot != genesis {
+		return fmt.Errorf("%w: have %s, want %s", errInterchangeGenesis,
+			interchange.Metadata.GenesisValidatorsRoot, genesis)
+	}
+
+	// Validate all the data before writing anything to the database
+	records := make([]*types.SignedVoteRecord, 0, len(interchange.Data))
+	for _, data := range interchange.Data {
+		var publicKey types.BLSPublicKey
+		if len(data.PublicKey) != len(publicKey) {
+			return fmt.Errorf("%w: %s", errInterchangePublicKey, data.PublicKey)
+		}
+		copy(publicKey[:], data.PublicKey)
+
+		if len(data.SignedBlocks) == 0 {
+			log.Warn("Skip public key without signed block", "publicKey", data.PublicKey)
+			continue
+		}
+		record := &types.SignedVoteRecord{PublicKey: publicKey}
+		for i, block := range data.SignedBlocks {
+			if i == 0 || block.Slot > record.TargetNumber {
+				record.TargetNumber = block.Slot
+				record.SigningRoot = common.Hash{}
+				if block.SigningRoot != nil {
+					record.SigningRoot = *block.SigningRoot
+				}
+			}
+		}
+		records = append(records, record)
+	}
+
+	protection.mu.Lock()
+	defer protection.mu.Unlock()
+
+	for _, record := range records {
+		existing := rawdb.ReadSignedVoteRecord(protection.db, record.PublicKey)
+		if existing != nil && existing.TargetNumber >= record.TargetNumber {
+			continue
+		}
+		rawdb.WriteSignedVoteRecord(protection.db, record)
+	}
+	log.Info("Imported slashing protection data", "keys", len(records))
+	return nil
+}
+
+// ReadInterchange decodes the interchange data in JSON.
+func ReadInterchange(r io.Reader) (*Interchange, error) {
+	var interchange Interchange
+	if err := json.NewDecoder(r).Decode(&interchange); err != nil {
+		return nil, err
+	}
+	return &interchange, nil
+}
@@ -0,0 +1,95 @@
+package vote
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestSlashingProtection(t *testing.T) {
+	protection := NewSlashingProtection(rawdb.NewMemoryDatabase())
+	publicKey := types.BLSPublicKey{0x1}
+
+	vote1 := &types.VoteData{TargetNumber: 10, TargetHash: common.Hash{0x1}}
+	if err := protection.CheckAndRecord(publicKey, vote1); err != nil {
+		t.Fatalf("Failed to sign first vote, err %s", err)
+	}
+	// Signing the same vote again is safe
+	if err := protection.CheckAndRecord(publicKey, vote1); err != nil {
+		t.Fatalf("Failed to sign the same vote, err %s", err)
+	}
+
+	vote2 := &types.VoteData{TargetNumber: 10, TargetHash: common.Hash{0x2}}
+	if err := protection.CheckAndRecord(publicKey, vote2); !errors.Is(err, errDoubleVote) {
+		t.Fatalf("Expect error %s, have %s", errDoubleVote, err)
+	}
+
+	vote3 := &types.VoteData{TargetNumber: 9, TargetHash: common.Hash{0x3}}
+	if err := protection.CheckAndRecord(publicKey, vote3); !errors.Is(err, errStaleVote) {
+		t.Fatalf("Expect error %s, have %s", errStaleVote, err)
+	}
+
+	// Other key is not affected
+	if err := protection.CheckAndRecord(types.BLSPublicKey{0x2}, vote2); err != nil {
+		t.Fatalf("Failed to sign vote with other key, err %s", err)
+	}
+
+	vote4 := &types.VoteData{TargetNumber: 11, TargetHash: common.Hash{0x4}}
+	if err := protection.CheckAndRecord(publicKey, vote4); err != nil {
+		t.Fatalf("Failed to sign higher vote, err %s", err)
+	}
+}
+
+func TestSlashingProtectionInterchange(t *testing.T) {
+	genesis := common.Hash{0xff}
+	publicKey := types.BLSPublicKey{0x1}
+	vote := &types.VoteData{TargetNumber: 10, TargetHash: common.Hash{0x1}}
+
+	source := NewSlashingProtection(rawdb.NewMemoryDatabase())
+	if err := source.CheckAndRecord(publicKey, vote); err != nil {
+		t.Fatalf("Failed to sign vote, err %s", err)
+	}
+
+	data, err := json.Marshal(source.Export(genesis))
+	if err != nil {
+		t.Fatalf("Failed to encode interchange, err %s", err)
+	}
+	interchange, err := ReadInterchange(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to decode interchange, err %s", err)
+	}
+
+	destination := NewSlashingProtection(rawdb.NewMemoryDatabase())
+	if err := destination.Import(interchange, common.Hash{}); !errors.Is(err, errInterchangeGenesis) {
+		t.Fatalf("Expect error %s, have %s", errInterchangeGenesis, err)
+	}
+	if err := destination.Import(interchange, genesis); err != nil {
+		t.Fatalf("Failed to import interchange, err %s", err)
+	}
+
+	// The imported vote blocks the conflicting one
+	if err := destination.CheckAndRecord(publicKey, vote); err != nil {
+		t.Fatalf("Failed to sign the same vote, err %s", err)
+	}
+	conflict := &types.VoteData{TargetNumber: 10, TargetHash: common.Hash{0x2}}
+	if err := destination.CheckAndRecord(publicKey, conflict); !errors.Is(err, errDoubleVote) {
+		t.Fatalf("Expect error %s, have %s", errDoubleVote, err)
+	}
+
+	// Importing older data does not lower the latest signed vote
+	higher := &types.VoteData{TargetNumber: 20, TargetHash: common.Hash{0x3}}
+	if err := destination.CheckAndRecord(publicKey, higher); err != nil {
+		t.Fatalf("Failed to sign higher vote, err %s", err)
+	}
+	if err := destination.Import(interchange, genesis); err != nil {
+		t.Fatalf("Failed to import interchange, err %s", err)
+	}
+	if err := destination.CheckAndRecord(publicKey, vote); !errors.Is(err, errStaleVote) {
+		t.Fatalf("Expect error %s, have %s", errStaleVote, err)
+	}
+}
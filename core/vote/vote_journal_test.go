@@ -0,0 +1,81 @@
+package vote
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func newJournalTestVote(number uint64) *types.VoteEnvelope {
+	return &types.VoteEnvelope{
+		RawVoteEnvelope: types.RawVoteEnvelope{
+			PublicKey: types.BLSPublicKey{byte(number)},
+			Signature: types.BLSSignature{byte(number)},
+			Data: &types.VoteData{
+				TargetNumber: number,
+				TargetHash:   common.BigToHash(common.Big1),
+			},
+		},
+	}
+}
+
+func loadJournalVotes(t *testing.T, journal *voteJournal) []*types.VoteEnvelope {
+	var loaded []*types.VoteEnvelope
+	if err := journal.load(func(vote *types.VoteEnvelope) bool {
+		loaded = append(loaded, vote)
+		return true
+	}); err != nil {
+		t.Fatalf("Failed to load journal, err %s", err)
+	}
+	return loaded
+}
+
+func TestVoteJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "votes.rlp")
+	journal := newVoteJournal(path)
+
+	// Loading a non-existent journal is a no-op
+	if votes := loadJournalVotes(t, journal); len(votes) != 0 {
+		t.Fatalf("Loaded votes, expect %d have %d", 0, len(votes))
+	}
+	if err := journal.insert(newJournalTestVote(1)); err != errNoActiveJournal {
+		t.Fatalf("Insert without active journal, expect %v have %v", errNoActiveJournal, err)
+	}
+
+	if err := journal.rotate([]*types.VoteEnvelope{newJournalTestVote(1), newJournalTestVote(2)}); err != nil {
+		t.Fatalf("Failed to rotate journal, err %s", err)
+	}
+	if err := journal.insert(newJournalTestVote(3)); err != nil {
+		t.Fatalf("Failed to insert vote, err %s", err)
+	}
+	if err := journal.close(); err != nil {
+		t.Fatalf("Failed to close journal, err %s", err)
+	}
+
+	votes := loadJournalVotes(t, newVoteJournal(path))
+	if len(votes) != 3 {
+		t.Fatalf("Loaded votes, expect %d have %d", 3, len(votes))
+	}
+	for i, vote := range votes {
+		expected := newJournalTestVote(uint64(i + 1))
+		if vote.Hash() != expected.Hash() {
+			t.Fatalf("Vote %d mismatches, expect %s have %s", i, expected.Hash(), vote.Hash())
+		}
+	}
+
+	// Rotating drops the votes which are no longer in the pool
+	journal = newVoteJournal(path)
+	if err := journal.rotate([]*types.VoteEnvelope{newJournalTestVote(3)}); err != nil {
+		t.Fatalf("Failed to rotate journal, err %s", err)
+	}
+	journal.close()
+	if _, err := os.Stat(path + ".new"); !os.IsNotExist(err) {
+		t.Fatalf("Temporary journal is not removed, err %v", err)
+	}
+	if votes := loadJournalVotes(t, newVoteJournal(path)); len(votes) != 1 {
+		t.Fatalf("Loaded votes, expect %d have %d", 1, len(votes))
+	}
+}
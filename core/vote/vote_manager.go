@@ -15,7 +15,10 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 )
 
-var votesManagerCounter = metrics.NewRegisteredCounter("votesManager/local", nil)
+var (
+	votesManagerCounter            = metrics.NewRegisteredCounter("votesManager/local", nil)
+	votesSlashingProtectionCounter = metrics.NewRegisteredCounter("votesManager/slashingprotection", nil)
+)
 
 // Backend wraps all methods required for voting.
 type Backend interface {
@@ -38,8 +41,9 @@ type VoteManager struct {
 	chainHeadCh  chan core.ChainHeadEvent
 	chainHeadSub event.Subscription
 
-	pool   *VotePool
-	signer *VoteSigner
+	pool       *VotePool
+	signer     *VoteSigner
+	protection *SlashingProtection
 
 	engine consensus.FastFinalityPoSA
 
@@ -54,7 +58,7 @@ func NewVoteManager(
 	chain *core.BlockChain,
 	pool *VotePool,
 	enableSign bool,
-	blsPasswordPath, blsWalletPath string,
+	blsPasswordPath, blsWalletPath, blsRemoteSigner string,
 	engine consensus.FastFinalityPoSA,
 	debug *Debug,
 ) (*VoteManager, error) {
@@ -72,13 +76,22 @@ func NewVoteManager(
 	}
 
 	if enableSign {
-		// Create voteSigner.
-		voteSigner, err := NewVoteSigner(blsPasswordPath, blsWalletPath)
+		// Create voteSigner, the remote signer takes precedence over the local keystore.
+		var (
+			voteSigner *VoteSigner
+			err        error
+		)
+		if blsRemoteSigner != "" {
+			voteSigner, err = NewRemoteVoteSigner(blsRemoteSigner)
+		} else {
+			voteSigner, err = NewVoteSigner(blsPasswordPath, blsWalletPath)
+		}
 		if err != nil {
 			return nil, err
 		}
 		log.Info("BLS voter public key", "public key", hex.EncodeToString(voteSigner.pubKey[:]))
 		voteManager.signer = voteSigner
+		voteManager.protection = NewSlashingProtection(db)
 	}
 
 	// Subscribe to chain head event.
@@ -161,6 +174,11 @@ func (voteManager *VoteManager) loop() {
 			// Put Vote into journal and VotesPool if we are active validator and allow to sign it.
 			if ok := voteManager.UnderRules(curHead); ok {
 				log.Debug("curHead is underRules for voting")
+				if err := voteManager.protection.CheckAndRecord(voteManager.signer.pubKey, vote); err != nil {
+					log.Error("Refuse to sign vote by slashing protection", "err", err, "votedBlockNumber", vote.TargetNumber, "votedBlockHash", vote.TargetHash)
+					votesSlashingProtectionCounter.Inc(1)
+					continue
+				}
 				if err := voteManager.signer.SignVote(voteMessage); err != nil {
 					log.Error("Failed to sign vote", "err", err, "votedBlockNumber", voteMessage.Data.TargetNumber, "votedBlockHash", voteMessage.Data.TargetHash, "voteMessageHash", voteMessage.Hash())
 					votesSigningErrorCounter.Inc(1)
@@ -2,13 +2,17 @@ package vote
 
 import (
 	"container/heap"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/consortium/v2/finality"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
@@ -27,11 +31,41 @@ const (
 
 	fetchCheckFrequency = 1 * time.Millisecond
 	fetchRetry          = 500
+
+	// journalPeer is the sender recorded for the votes loaded from journal
+	journalPeer = "journal"
+
+	// voteBatchWindow is the time the incoming votes are collected for before
+	// their signatures are verified as a batch.
+	voteBatchWindow = 50 * time.Millisecond
+	// maxVoteBatchSize is the number of collected votes which triggers the
+	// batch verification before the end of the window.
+	maxVoteBatchSize = 128
 )
 
 var (
 	localCurVotesPqGauge    = metrics.NewRegisteredGauge("curVotesPq/local", nil)
 	localFutureVotesPqGauge = metrics.NewRegisteredGauge("futureVotesPq/local", nil)
+
+	invalidSignatureVoteMeter = metrics.NewRegisteredMeter("votepool/invalid/signature", nil)
+	unauthorizedVoteMeter     = metrics.NewRegisteredMeter("votepool/invalid/voter", nil)
+	invalidTargetVoteMeter    = metrics.NewRegisteredMeter("votepool/invalid/target", nil)
+
+	voteBatchSizeHist    = metrics.NewRegisteredHistogram("votepool/batch/size", nil, metrics.NewExpDecaySample(1028, 0.015))
+	voteBatchVerifyTimer = metrics.NewRegisteredTimer("votepool/batch/verify", nil)
+	voteBatchFailMeter   = metrics.NewRegisteredMeter("votepool/batch/fail", nil)
+	verifiedVoteMeter    = metrics.NewRegisteredMeter("votepool/verified", nil)
+	duplicateVoteMeter   = metrics.NewRegisteredMeter("votepool/duplicate", nil)
+)
+
+var (
+	// ErrInvalidVoteSignature is returned if the BLS signature of the vote
+	// doesn't match its data and public key.
+	ErrInvalidVoteSignature = errors.New("invalid vote signature")
+
+	// errVoteBoxFull is returned if the vote pool has reached the limit of
+	// votes for the target block.
+	errVoteBoxFull = errors.New("too many votes for target block")
 )
 
 type VoteBox struct {
@@ -43,14 +77,18 @@ type VoteBox struct {
 type voteWithPeer struct {
 	vote *types.VoteEnvelope
 	peer string
+
+	verified bool  // Whether the signature has already been verified in a batch
+	sigErr   error // Result of the batch signature verification
 }
 
 type VotePool struct {
 	chain *core.BlockChain
 	mu    sync.RWMutex
 
-	votesFeed event.Feed
-	scope     event.SubscriptionScope
+	votesFeed        event.Feed
+	invalidVotesFeed event.Feed
+	scope            event.SubscriptionScope
 
 	curVotes    map[common.Hash]*VoteBox
 	futureVotes map[common.Hash]*VoteBox
@@ -69,14 +107,24 @@ type VotePool struct {
 	numFutureVotePerPeer map[string]uint64      // number of queued votes per peer
 	originatedFrom       map[common.Hash]string // mapping from vote hash to the sender
 	justifiedBlockNumber uint64
+
+	invalidVoteEvents []core.InvalidVoteEvent // Invalid vote events to send once the mutex is released
+
+	journal   *voteJournal  // Journal of votes to back up to disk, nil if disabled
+	rejournal time.Duration // Time interval to regenerate the vote journal
 }
 
 type votesPriorityQueue []*types.VoteData
 
+// NewVotePool creates a vote pool. If journal is not empty, the votes in the
+// pool are persisted to the journal file and replayed on startup, the journal
+// is regenerated every rejournal interval.
 func NewVotePool(
 	chain *core.BlockChain,
 	engine consensus.FastFinalityPoSA,
 	maxCurVoteAmountPerBlock int,
+	journal string,
+	rejournal time.Duration,
 ) *VotePool {
 	votePool := &VotePool{
 		chain:                    chain,
@@ -90,6 +138,16 @@ func NewVotePool(
 		maxCurVoteAmountPerBlock: maxCurVoteAmountPerBlock,
 		numFutureVotePerPeer:     make(map[string]uint64),
 		originatedFrom:           make(map[common.Hash]string),
+		rejournal:                rejournal,
+	}
+
+	if journal != "" {
+		if votePool.rejournal < time.Second {
+			log.Warn("Sanitizing invalid vote pool journal time", "provided", votePool.rejournal, "updated", time.Second)
+			votePool.rejournal = time.Second
+		}
+		votePool.journal = newVoteJournal(journal)
+		votePool.loadJournal()
 	}
 
 	// Subscribe events from blockchain and start the main event loop.
@@ -101,6 +159,23 @@ func NewVotePool(
 
 // loop is the vote pool's main even loop, waiting for and reacting to outside blockchain events and votes channel event.
 func (pool *VotePool) loop() {
+	var journal <-chan time.Time
+	if pool.journal != nil {
+		ticker := time.NewTicker(pool.rejournal)
+		defer ticker.Stop()
+		journal = ticker.C
+		defer func() {
+			pool.mu.Lock()
+			pool.journal.close()
+			pool.mu.Unlock()
+		}()
+	}
+
+	// The votes received within the batch window, verified all at once
+	var (
+		batch     []*voteWithPeer
+		batchDone <-chan time.Time
+	)
 	for {
 		select {
 		// Handle ChainHeadEvent.
@@ -114,17 +189,71 @@ func (pool *VotePool) loop() {
 				pool.prune(latestBlockNumber)
 				pool.transferVotesFromFutureToCur(ev.Block.Header())
 				pool.mu.Unlock()
+				pool.sendInvalidVoteEvents()
 			}
 		case <-pool.chainHeadSub.Err():
 			return
 
-		// Handle votes channel and put the vote into vote pool.
+		// Handle votes channel and collect the vote for the batch verification.
 		case vote := <-pool.votesCh:
-			pool.putIntoVotePool(vote)
+			batch = append(batch, vote)
+			if len(batch) >= maxVoteBatchSize {
+				pool.putVotesIntoVotePool(batch)
+				batch, batchDone = nil, nil
+			} else if batchDone == nil {
+				batchDone = time.After(voteBatchWindow)
+			}
+
+		// Verify the collected votes and put them into vote pool.
+		case <-batchDone:
+			pool.putVotesIntoVotePool(batch)
+			batch, batchDone = nil, nil
+
+		// Regenerate the journal to drop the pruned votes.
+		case <-journal:
+			pool.mu.Lock()
+			if err := pool.journal.rotate(pool.allVotes()); err != nil {
+				log.Warn("Failed to rotate vote journal", "err", err)
+			}
+			pool.mu.Unlock()
 		}
 	}
 }
 
+// loadJournal replays the votes in the journal into the pool, the votes go
+// through the same checks as the ones received from peers so the stale and
+// invalid votes are dropped. The journal is then regenerated with the loaded
+// votes.
+func (pool *VotePool) loadJournal() {
+	header := pool.chain.CurrentBlock().Header()
+	pool.justifiedBlockNumber, _ = pool.engine.GetJustifiedBlock(pool.chain, header.Number.Uint64(), header.Hash())
+
+	if err := pool.journal.load(func(vote *types.VoteEnvelope) bool {
+		return pool.putIntoVotePool(&voteWithPeer{vote: vote, peer: journalPeer})
+	}); err != nil {
+		log.Warn("Failed to load vote journal", "err", err)
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if err := pool.journal.rotate(pool.allVotes()); err != nil {
+		log.Warn("Failed to rotate vote journal", "err", err)
+	}
+}
+
+// allVotes returns all current and future votes in the pool.
+// The caller must hold the pool mutex
+func (pool *VotePool) allVotes() []*types.VoteEnvelope {
+	var votes []*types.VoteEnvelope
+	for _, voteBox := range pool.curVotes {
+		votes = append(votes, voteBox.voteMessages...)
+	}
+	for _, voteBox := range pool.futureVotes {
+		votes = append(votes, voteBox.voteMessages...)
+	}
+	return votes
+}
+
 func (pool *VotePool) PutVote(peer string, vote *types.VoteEnvelope) {
 	select {
 	case pool.votesCh <- &voteWithPeer{vote: vote, peer: peer}:
@@ -133,6 +262,161 @@ func (pool *VotePool) PutVote(peer string, vote *types.VoteEnvelope) {
 	}
 }
 
+// putVotesIntoVotePool verifies the signatures of the votes as a batch and puts
+// them into the vote pool. The votes which would be rejected by the pool anyway
+// are dropped before the verification.
+func (pool *VotePool) putVotesIntoVotePool(votes []*voteWithPeer) {
+	votes = pool.filterVotes(votes)
+	errs := verifyVoteSignatures(votes)
+	for i, vote := range votes {
+		vote.verified, vote.sigErr = true, errs[i]
+		pool.putIntoVotePool(vote)
+	}
+}
+
+// filterVotes drops the votes which are rejected by the pool regardless of their
+// signatures, so that no signature verification is wasted on them: the votes
+// already in the pool or duplicated in the batch, the votes out of the accepted
+// range, the votes not newer than the justified block and the votes over the
+// future votes limit of their peer or the limit of votes for their target block.
+// The limits take the votes kept earlier in the batch into account.
+func (pool *VotePool) filterVotes(votes []*voteWithPeer) []*voteWithPeer {
+	headNumber := pool.chain.CurrentBlock().NumberU64()
+
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	var (
+		kept       = make([]*voteWithPeer, 0, len(votes))
+		seen       = make(map[common.Hash]struct{}, len(votes))
+		duplicates int
+
+		futureVotesPerPeer = make(map[string]uint64)
+		votesPerBlock      = make(map[common.Hash]int)
+	)
+	for _, vote := range votes {
+		voteHash := vote.vote.Hash()
+		if _, ok := seen[voteHash]; ok {
+			duplicates++
+			continue
+		}
+		if _, ok := pool.originatedFrom[voteHash]; ok {
+			duplicates++
+			continue
+		}
+		seen[voteHash] = struct{}{}
+
+		targetNumber, targetHash := vote.vote.Data.TargetNumber, vote.vote.Data.TargetHash
+		if !inVoteRange(targetNumber, headNumber) || targetNumber <= pool.justifiedBlockNumber {
+			continue
+		}
+		voteBox, maxVoteAmountPerBlock := pool.curVotes[targetHash], pool.maxCurVoteAmountPerBlock
+		isFutureVote := pool.chain.GetHeaderByHash(targetHash) == nil
+		if isFutureVote {
+			voteBox, maxVoteAmountPerBlock = pool.futureVotes[targetHash], maxFutureVoteAmountPerBlock
+		}
+		numVotes := votesPerBlock[targetHash]
+		if voteBox != nil {
+			numVotes += len(voteBox.voteMessages)
+		}
+		if numVotes >= maxVoteAmountPerBlock {
+			log.Debug("Dropping vote for full vote box", "voteHash", voteHash, "targetHash", targetHash)
+			continue
+		}
+		if isFutureVote {
+			if pool.numFutureVotePerPeer[vote.peer]+futureVotesPerPeer[vote.peer] >= maxFutureVotePerPeer {
+				log.Debug("Dropping future vote over the peer limit", "peer", vote.peer, "voteHash", voteHash)
+				continue
+			}
+			futureVotesPerPeer[vote.peer]++
+		}
+		votesPerBlock[targetHash]++
+		kept = append(kept, vote)
+	}
+	duplicateVoteMeter.Mark(int64(duplicates))
+	return kept
+}
+
+// inVoteRange reports whether the target number of a vote is in the range
+// (headNumber-lowerLimitOfVoteBlockNumber, headNumber+upperLimitOfVoteBlockNumber].
+func inVoteRange(targetNumber, headNumber uint64) bool {
+	return targetNumber+lowerLimitOfVoteBlockNumber-1 >= headNumber && targetNumber <= headNumber+upperLimitOfVoteBlockNumber
+}
+
+// verifyVoteSignatures verifies the BLS signatures of the votes as a batch. If
+// the batch verification fails, the batch is split in halves which are verified
+// in turn, until the invalid signatures are found. The verification error of
+// each vote is returned.
+//
+// The signatures are not aggregated per vote data, as the voters are not known
+// to be authorized yet and the aggregation is prone to rogue key attacks.
+func verifyVoteSignatures(votes []*voteWithPeer) []error {
+	errs := make([]error, len(votes))
+	if len(votes) == 0 {
+		return errs
+	}
+	start := time.Now()
+	defer func() {
+		voteBatchVerifyTimer.UpdateSince(start)
+		voteBatchSizeHist.Update(int64(len(votes)))
+		verifiedVoteMeter.Mark(int64(len(votes)))
+	}()
+
+	var (
+		batch   = bls.NewSet()
+		indexes = make([]int, 0, len(votes))
+	)
+	for i, vote := range votes {
+		publicKey, err := bls.PublicKeyFromBytes(vote.vote.PublicKey[:])
+		if err != nil {
+			errs[i] = fmt.Errorf("%w: %v", ErrInvalidVoteSignature, err)
+			continue
+		}
+		batch.Signatures = append(batch.Signatures, vote.vote.Signature[:])
+		batch.PublicKeys = append(batch.PublicKeys, publicKey)
+		batch.Messages = append(batch.Messages, vote.vote.Data.Hash())
+		batch.Descriptions = append(batch.Descriptions, vote.vote.Hash().Hex())
+		indexes = append(indexes, i)
+	}
+	if len(indexes) == 0 {
+		return errs
+	}
+	if !verifySignatureBatch(votes, batch, indexes, errs) {
+		voteBatchFailMeter.Mark(1)
+	}
+	return errs
+}
+
+// verifySignatureBatch verifies the signature batch of the votes at the given
+// indexes, bisecting it if it fails. The error of each invalid signature is set
+// in errs at its index. It reports whether the whole batch is valid.
+func verifySignatureBatch(votes []*voteWithPeer, batch *bls.SignatureBatch, indexes []int, errs []error) bool {
+	if len(indexes) == 1 {
+		if err := votes[indexes[0]].vote.Verify(); err != nil {
+			errs[indexes[0]] = fmt.Errorf("%w: %v", ErrInvalidVoteSignature, err)
+			return false
+		}
+		return true
+	}
+	if valid, err := batch.Verify(); err == nil && valid {
+		return true
+	}
+	half := len(indexes) / 2
+	verifySignatureBatch(votes, &bls.SignatureBatch{
+		Signatures:   batch.Signatures[:half],
+		PublicKeys:   batch.PublicKeys[:half],
+		Messages:     batch.Messages[:half],
+		Descriptions: batch.Descriptions[:half],
+	}, indexes[:half], errs)
+	verifySignatureBatch(votes, &bls.SignatureBatch{
+		Signatures:   batch.Signatures[half:],
+		PublicKeys:   batch.PublicKeys[half:],
+		Messages:     batch.Messages[half:],
+		Descriptions: batch.Descriptions[half:],
+	}, indexes[half:], errs)
+	return false
+}
+
 func (pool *VotePool) putIntoVotePool(voteWithPeerInfo *voteWithPeer) bool {
 	vote := voteWithPeerInfo.vote
 	peer := voteWithPeerInfo.peer
@@ -143,11 +427,12 @@ func (pool *VotePool) putIntoVotePool(voteWithPeerInfo *voteWithPeer) bool {
 	headNumber := header.Number.Uint64()
 
 	// Make sure in the range (currentHeight-lowerLimitOfVoteBlockNumber, currentHeight+upperLimitOfVoteBlockNumber].
-	if targetNumber+lowerLimitOfVoteBlockNumber-1 < headNumber || targetNumber > headNumber+upperLimitOfVoteBlockNumber {
+	if !inVoteRange(targetNumber, headNumber) {
 		log.Debug("BlockNumber of vote is outside the range of header-256~header+11, will be discarded")
 		return false
 	}
 
+	defer pool.sendInvalidVoteEvents()
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
@@ -184,23 +469,26 @@ func (pool *VotePool) putIntoVotePool(voteWithPeerInfo *voteWithPeer) bool {
 
 	if isFutureVote {
 		// As we cannot fully verify the future vote, we need to set a limit of
-		// future votes per peer to void be DOSed by peer.
-		if pool.numFutureVotePerPeer[peer] >= maxFutureVotePerPeer {
+		// future votes per peer to void be DOSed by peer. The votes replayed
+		// from the journal were received from many peers, they are not limited.
+		if peer != journalPeer && pool.numFutureVotePerPeer[peer] >= maxFutureVotePerPeer {
 			return false
 		}
 		pool.numFutureVotePerPeer[peer]++
 	}
 
-	if ok := pool.basicVerify(vote, headNumber, votes, isFutureVote, voteHash); !ok {
+	if err := pool.basicVerify(voteWithPeerInfo, headNumber, votes, isFutureVote, voteHash); err != nil {
 		if isFutureVote {
 			pool.numFutureVotePerPeer[peer]--
 		}
+		pool.reportInvalidVote(peer, vote, err)
 		return false
 	}
 
 	if !isFutureVote {
 		// Verify if the vote comes from valid validators based on voteAddress (BLSPublicKey), only verify curVotes here, will verify futureVotes in transfer process.
-		if pool.engine.VerifyVote(pool.chain, vote) != nil {
+		if err := pool.engine.VerifyVote(pool.chain, vote); err != nil {
+			pool.reportInvalidVote(peer, vote, err)
 			return false
 		}
 
@@ -210,6 +498,11 @@ func (pool *VotePool) putIntoVotePool(voteWithPeerInfo *voteWithPeer) bool {
 	}
 
 	pool.putVote(votes, votesPq, vote, voteData, voteHash, isFutureVote)
+	if pool.journal != nil {
+		if err := pool.journal.insert(vote); err != nil {
+			log.Warn("Failed to journal vote", "voteHash", voteHash, "err", err)
+		}
+	}
 
 	return true
 }
@@ -218,6 +511,48 @@ func (pool *VotePool) SubscribeNewVoteEvent(ch chan<- core.NewVoteEvent) event.S
 	return pool.scope.Track(pool.votesFeed.Subscribe(ch))
 }
 
+// SubscribeInvalidVoteEvent registers a subscription of InvalidVoteEvent, which
+// is posted when a vote sent by a peer is proven invalid.
+func (pool *VotePool) SubscribeInvalidVoteEvent(ch chan<- core.InvalidVoteEvent) event.Subscription {
+	return pool.scope.Track(pool.invalidVotesFeed.Subscribe(ch))
+}
+
+// reportInvalidVote records the verification failure of the vote sent by the
+// peer. Only the failures proving the vote invalid are reported to the
+// subscribers, the votes of the local node and the journal are not reported.
+// The caller must hold the pool mutex and call sendInvalidVoteEvents after
+// releasing it, so that no subscriber is waited for while holding the mutex.
+func (pool *VotePool) reportInvalidVote(peer string, vote *types.VoteEnvelope, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidVoteSignature):
+		invalidSignatureVoteMeter.Mark(1)
+	case errors.Is(err, finality.ErrUnauthorizedFinalityVoter):
+		unauthorizedVoteMeter.Mark(1)
+	case errors.Is(err, finality.ErrInvalidTargetNumber):
+		invalidTargetVoteMeter.Mark(1)
+	default:
+		return
+	}
+	log.Debug("Invalid vote", "peer", peer, "voteHash", vote.Hash(), "err", err)
+	if peer == "" || peer == journalPeer {
+		return
+	}
+	pool.invalidVoteEvents = append(pool.invalidVoteEvents, core.InvalidVoteEvent{Peer: peer, Vote: vote, Err: err})
+}
+
+// sendInvalidVoteEvents sends the invalid vote events recorded by reportInvalidVote
+// to the subscribers. The caller must not hold the pool mutex.
+func (pool *VotePool) sendInvalidVoteEvents() {
+	pool.mu.Lock()
+	events := pool.invalidVoteEvents
+	pool.invalidVoteEvents = nil
+	pool.mu.Unlock()
+
+	for _, ev := range events {
+		pool.invalidVotesFeed.Send(ev)
+	}
+}
+
 // The vote pool's mutex must already be acquired when calling this function
 func (pool *VotePool) putVote(m map[common.Hash]*VoteBox, votesPq *votesPriorityQueue, vote *types.VoteEnvelope, voteData *types.VoteData, voteHash common.Hash, isFutureVote bool) {
 	targetHash := vote.Data.TargetHash
@@ -292,7 +627,8 @@ func (pool *VotePool) transfer(blockHash common.Hash) {
 	validVotes := make([]*types.VoteEnvelope, 0, len(voteBox.voteMessages))
 	for _, vote := range voteBox.voteMessages {
 		// Verify if the vote comes from valid validators based on voteAddress (BLSPublicKey).
-		if pool.engine.VerifyVote(pool.chain, vote) != nil {
+		if err := pool.engine.VerifyVote(pool.chain, vote); err != nil {
+			pool.reportInvalidVote(pool.originatedFrom[vote.Hash()], vote, err)
 			continue
 		}
 
@@ -408,7 +744,15 @@ func (pool *VotePool) FetchVoteByBlockHash(blockHash common.Hash) []*types.VoteE
 	}
 }
 
-func (pool *VotePool) basicVerify(vote *types.VoteEnvelope, headNumber uint64, m map[common.Hash]*VoteBox, isFutureVote bool, voteHash common.Hash) bool {
+// HasEnoughVotes reports whether the pool holds enough finality votes to
+// justify the block, according to the validator set voting for it.
+func (pool *VotePool) HasEnoughVotes(number uint64, hash common.Hash) bool {
+	validators := pool.engine.GetActiveValidatorAt(pool.chain, number, hash)
+	return len(pool.FetchVoteByBlockHash(hash)) >= finality.FinalityThreshold(len(validators))
+}
+
+func (pool *VotePool) basicVerify(voteWithPeerInfo *voteWithPeer, headNumber uint64, m map[common.Hash]*VoteBox, isFutureVote bool, voteHash common.Hash) error {
+	vote := voteWithPeerInfo.vote
 	targetHash := vote.Data.TargetHash
 
 	// To prevent DOS attacks, make sure no more than 21 votes per blockHash if not futureVotes
@@ -419,17 +763,24 @@ func (pool *VotePool) basicVerify(vote *types.VoteEnvelope, headNumber uint64, m
 	}
 	if voteBox, ok := m[targetHash]; ok {
 		if len(voteBox.voteMessages) >= maxVoteAmountPerBlock {
-			return false
+			return errVoteBoxFull
 		}
 	}
 
-	// Verify bls signature.
+	// Verify bls signature if it's not verified in a batch.
+	if voteWithPeerInfo.verified {
+		if voteWithPeerInfo.sigErr != nil {
+			log.Error("Failed to verify voteMessage", "err", voteWithPeerInfo.sigErr)
+			return voteWithPeerInfo.sigErr
+		}
+		return nil
+	}
 	if err := vote.Verify(); err != nil {
 		log.Error("Failed to verify voteMessage", "err", err)
-		return false
+		return fmt.Errorf("%w: %v", ErrInvalidVoteSignature, err)
 	}
 
-	return true
+	return nil
 }
 
 func (pq votesPriorityQueue) Less(i, j int) bool {
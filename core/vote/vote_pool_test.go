@@ -20,6 +20,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/consortium/v2/finality"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -105,7 +106,7 @@ func testVotePool(t *testing.T, isValidRules bool) {
 	mockEngine := &mockPOSA{}
 
 	// Create vote pool
-	votePool := NewVotePool(chain, mockEngine, 22)
+	votePool := NewVotePool(chain, mockEngine, 22, "", 0)
 
 	// Create vote manager
 	// Create a temporary file for the votes journal
@@ -124,9 +125,9 @@ func testVotePool(t *testing.T, isValidRules bool) {
 		voteManager *VoteManager
 	)
 	if isValidRules {
-		voteManager, err = NewVoteManager(newTestBackend(), db, params.TestChainConfig, chain, votePool, true, walletPasswordDir, walletDir, mockEngine, nil)
+		voteManager, err = NewVoteManager(newTestBackend(), db, params.TestChainConfig, chain, votePool, true, walletPasswordDir, walletDir, "", mockEngine, nil)
 	} else {
-		voteManager, err = NewVoteManager(newTestBackend(), db, params.TestChainConfig, chain, votePool, true, walletPasswordDir, walletDir, mockEngine, &Debug{ValidateRule: func(header *types.Header) error {
+		voteManager, err = NewVoteManager(newTestBackend(), db, params.TestChainConfig, chain, votePool, true, walletPasswordDir, walletDir, "", mockEngine, &Debug{ValidateRule: func(header *types.Header) error {
 			return errors.New("mock error")
 		}})
 	}
@@ -402,7 +403,7 @@ func TestVotePoolDosProtection(t *testing.T) {
 	mockEngine := &mockPOSA{}
 
 	// Create vote pool
-	votePool := NewVotePool(chain, mockEngine, 22)
+	votePool := NewVotePool(chain, mockEngine, 22, "", 0)
 
 	for i := 0; i < maxFutureVotePerPeer; i++ {
 		vote := generateVote(1, common.BigToHash(big.NewInt(int64(i+1))), secretKey)
@@ -485,7 +486,7 @@ func (m *mockPOSAv2) VerifyVote(chain consensus.ChainHeaderReader, vote *types.V
 	}
 
 	if header.Number.Uint64() != vote.Data.TargetNumber {
-		return errors.New("wrong target number in vote")
+		return finality.ErrInvalidTargetNumber
 	}
 
 	return nil
@@ -517,7 +518,7 @@ func TestVotePoolWrongTargetNumber(t *testing.T) {
 	mockEngine := &mockPOSAv2{}
 
 	// Create vote pool
-	votePool := NewVotePool(chain, mockEngine, 22)
+	votePool := NewVotePool(chain, mockEngine, 22, "", 0)
 
 	// bs[0] is the block 1 so the target block number must be 1.
 	// Here we provide wrong target number 0
@@ -529,3 +530,320 @@ func TestVotePoolWrongTargetNumber(t *testing.T) {
 		t.Fatalf("Current vote length, expect %d have %d", 0, len(votePool.curVotes))
 	}
 }
+
+func TestVotePoolInvalidVoteEvent(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	if err != nil {
+		t.Fatalf("Failed to create secret key, err %s", err)
+	}
+
+	// Create a database pre-initialize with a genesis block
+	db := rawdb.NewMemoryDatabase()
+	genesis := (&core.Genesis{
+		Config:  params.TestChainConfig,
+		Alloc:   core.GenesisAlloc{testAddr: {Balance: big.NewInt(1000000)}},
+		BaseFee: big.NewInt(params.InitialBaseFee),
+	}).MustCommit(db)
+	chain, _ := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFullFaker(), vm.Config{}, nil, nil)
+
+	bs, _ := core.GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 1, nil, true)
+	if _, err := chain.InsertChain(bs[:1]); err != nil {
+		panic(err)
+	}
+	votePool := NewVotePool(chain, &mockPOSAv2{}, 22, "", 0)
+
+	invalidVoteCh := make(chan core.InvalidVoteEvent, 10)
+	sub := votePool.SubscribeInvalidVoteEvent(invalidVoteCh)
+	defer sub.Unsubscribe()
+
+	expectEvent := func(peer string, want error) {
+		t.Helper()
+		select {
+		case ev := <-invalidVoteCh:
+			if ev.Peer != peer || !errors.Is(ev.Err, want) {
+				t.Fatalf("Invalid vote event mismatch, expect %s %v have %s %v", peer, want, ev.Peer, ev.Err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Invalid vote event timeout, expect %s %v", peer, want)
+		}
+	}
+
+	// The vote with wrong target number
+	votePool.PutVote("AAAA", generateVote(0, bs[0].Hash(), secretKey))
+	expectEvent("AAAA", finality.ErrInvalidTargetNumber)
+
+	// The vote whose signature doesn't match the vote data
+	vote := generateVote(1, bs[0].Hash(), secretKey)
+	vote.Signature = generateVote(1, common.Hash{0x01}, secretKey).Signature
+	votePool.PutVote("BBBB", vote)
+	expectEvent("BBBB", ErrInvalidVoteSignature)
+
+	// The invalid votes of the local node are not reported
+	vote = generateVote(1, bs[0].Hash(), secretKey)
+	vote.Signature = generateVote(1, common.Hash{0x02}, secretKey).Signature
+	votePool.PutVote("", vote)
+	select {
+	case ev := <-invalidVoteCh:
+		t.Fatalf("Unexpected invalid vote event %v", ev)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if len(votePool.curVotes) != 0 {
+		t.Fatalf("Current vote length, expect %d have %d", 0, len(votePool.curVotes))
+	}
+}
+
+func TestVotePoolBatchVerification(t *testing.T) {
+	var secretKeys []blsCommon.SecretKey
+	for i := 0; i < 4; i++ {
+		secretKey, err := bls.RandKey()
+		if err != nil {
+			t.Fatalf("Failed to create secret key, err %s", err)
+		}
+		secretKeys = append(secretKeys, secretKey)
+	}
+
+	// Create a database pre-initialize with a genesis block
+	db := rawdb.NewMemoryDatabase()
+	genesis := (&core.Genesis{
+		Config:  params.TestChainConfig,
+		Alloc:   core.GenesisAlloc{testAddr: {Balance: big.NewInt(1000000)}},
+		BaseFee: big.NewInt(params.InitialBaseFee),
+	}).MustCommit(db)
+	chain, _ := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFullFaker(), vm.Config{}, nil, nil)
+
+	bs, _ := core.GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 1, nil, true)
+	if _, err := chain.InsertChain(bs[:1]); err != nil {
+		panic(err)
+	}
+
+	// The batch of valid votes is verified at once
+	var votes []*voteWithPeer
+	for _, secretKey := range secretKeys {
+		votes = append(votes, &voteWithPeer{vote: generateVote(1, bs[0].Hash(), secretKey), peer: "AAAA"})
+	}
+	for i, err := range verifyVoteSignatures(votes) {
+		if err != nil {
+			t.Fatalf("Vote %d: unexpected verification error %v", i, err)
+		}
+	}
+
+	// The invalid vote is found after the batch verification fails
+	invalid := generateVote(1, bs[0].Hash(), secretKeys[0])
+	invalid.Signature = generateVote(1, common.Hash{0x01}, secretKeys[0]).Signature
+	votes[2] = &voteWithPeer{vote: invalid, peer: "BBBB"}
+	for i, err := range verifyVoteSignatures(votes) {
+		if i == 2 {
+			if !errors.Is(err, ErrInvalidVoteSignature) {
+				t.Fatalf("Vote %d: verification error mismatch, expect %v have %v", i, ErrInvalidVoteSignature, err)
+			}
+		} else if err != nil {
+			t.Fatalf("Vote %d: unexpected verification error %v", i, err)
+		}
+	}
+
+	// The batch is bisected until all the invalid votes are found
+	for i, secretKey := range secretKeys {
+		votes = append(votes, &voteWithPeer{vote: generateVote(1, bs[0].Hash(), secretKey), peer: fmt.Sprintf("%04d", i)})
+	}
+	invalid = generateVote(1, bs[0].Hash(), secretKeys[1])
+	invalid.Signature = generateVote(1, common.Hash{0x02}, secretKeys[1]).Signature
+	votes[len(votes)-1] = &voteWithPeer{vote: invalid, peer: "BBBB"}
+	for i, err := range verifyVoteSignatures(votes) {
+		if i == 2 || i == len(votes)-1 {
+			if !errors.Is(err, ErrInvalidVoteSignature) {
+				t.Fatalf("Vote %d: verification error mismatch, expect %v have %v", i, ErrInvalidVoteSignature, err)
+			}
+		} else if err != nil {
+			t.Fatalf("Vote %d: unexpected verification error %v", i, err)
+		}
+	}
+	votes = votes[:len(secretKeys)]
+
+	// The votes sent within the batch window are put into the pool, the
+	// duplicated ones are dropped before the verification
+	votePool := NewVotePool(chain, &mockPOSA{}, 22, "", 0)
+	invalidVoteCh := make(chan core.InvalidVoteEvent, 10)
+	sub := votePool.SubscribeInvalidVoteEvent(invalidVoteCh)
+	defer sub.Unsubscribe()
+
+	for _, vote := range votes {
+		votePool.PutVote(vote.peer, vote.vote)
+		votePool.PutVote("CCCC", vote.vote)
+	}
+	select {
+	case ev := <-invalidVoteCh:
+		if ev.Peer != "BBBB" || !errors.Is(ev.Err, ErrInvalidVoteSignature) {
+			t.Fatalf("Invalid vote event mismatch, expect %s %v have %s %v", "BBBB", ErrInvalidVoteSignature, ev.Peer, ev.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Invalid vote event timeout")
+	}
+	if votes := votePool.FetchVoteByBlockHash(bs[0].Hash()); len(votes) != len(secretKeys)-1 {
+		t.Fatalf("Current vote length, expect %d have %d", len(secretKeys)-1, len(votes))
+	}
+}
+
+func TestVotePoolFilterVotes(t *testing.T) {
+	// Create a database pre-initialize with a genesis block
+	db := rawdb.NewMemoryDatabase()
+	genesis := (&core.Genesis{
+		Config:  params.TestChainConfig,
+		Alloc:   core.GenesisAlloc{testAddr: {Balance: big.NewInt(1000000)}},
+		BaseFee: big.NewInt(params.InitialBaseFee),
+	}).MustCommit(db)
+	chain, _ := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFullFaker(), vm.Config{}, nil, nil)
+
+	bs, _ := core.GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 2, nil, true)
+	if _, err := chain.InsertChain(bs); err != nil {
+		panic(err)
+	}
+	votePool := NewVotePool(chain, &mockPOSA{}, 2, "", 0)
+
+	// The votes are told apart by their public keys, their signatures are not
+	// verified by the filter
+	var nonce int
+	newVote := func(peer string, number uint64, hash common.Hash) *voteWithPeer {
+		nonce++
+		vote := &types.VoteEnvelope{
+			RawVoteEnvelope: types.RawVoteEnvelope{
+				Data: &types.VoteData{TargetNumber: number, TargetHash: hash},
+			},
+		}
+		vote.PublicKey[0] = byte(nonce)
+		return &voteWithPeer{vote: vote, peer: peer}
+	}
+	var votes, want []*voteWithPeer
+
+	// The votes out of the accepted range are dropped
+	votes = append(votes, newVote("AAAA", 1000, common.Hash{0x01}))
+
+	// The votes not newer than the justified block are dropped
+	votePool.mu.Lock()
+	votePool.justifiedBlockNumber = 1
+	votePool.mu.Unlock()
+	votes = append(votes, newVote("AAAA", 1, bs[0].Hash()))
+
+	// The votes over the limit of the target block are dropped, including the
+	// ones duplicated in the batch
+	vote := newVote("AAAA", 2, bs[1].Hash())
+	votes = append(votes, vote, vote)
+	want = append(want, vote)
+	for i := 0; i < 2; i++ {
+		vote := newVote("BBBB", 2, bs[1].Hash())
+		votes = append(votes, vote)
+		if i == 0 {
+			want = append(want, vote)
+		}
+	}
+
+	// The future votes over the limit of the peer are dropped
+	for i := 0; i < maxFutureVotePerPeer+1; i++ {
+		vote := newVote("CCCC", 3, common.BigToHash(big.NewInt(int64(i+1))))
+		votes = append(votes, vote)
+		if i < maxFutureVotePerPeer {
+			want = append(want, vote)
+		}
+	}
+	vote = newVote("DDDD", 3, common.Hash{0x01})
+	votes = append(votes, vote)
+	want = append(want, vote)
+
+	have := votePool.filterVotes(votes)
+	if len(have) != len(want) {
+		t.Fatalf("Filtered vote length, expect %d have %d", len(want), len(have))
+	}
+	for i := range want {
+		if have[i] != want[i] {
+			t.Fatalf("Filtered vote %d mismatch, expect %v have %v", i, want[i].vote.Hash(), have[i].vote.Hash())
+		}
+	}
+}
+
+func TestVotePoolJournalFutureVotes(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	if err != nil {
+		t.Fatalf("Failed to create secret key, err %s", err)
+	}
+
+	// Create a database pre-initialize with a genesis block
+	db := rawdb.NewMemoryDatabase()
+	(&core.Genesis{
+		Config:  params.TestChainConfig,
+		Alloc:   core.GenesisAlloc{testAddr: {Balance: big.NewInt(1000000)}},
+		BaseFee: big.NewInt(params.InitialBaseFee),
+	}).MustCommit(db)
+	chain, _ := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFullFaker(), vm.Config{}, nil, nil)
+
+	// The journal holds more future votes than a single peer may queue
+	var (
+		path  = filepath.Join(t.TempDir(), "votes.rlp")
+		votes []*types.VoteEnvelope
+	)
+	for i := 0; i < maxFutureVotePerPeer+5; i++ {
+		votes = append(votes, generateVote(1, common.BigToHash(big.NewInt(int64(i+1))), secretKey))
+	}
+	journal := newVoteJournal(path)
+	if err := journal.rotate(votes); err != nil {
+		t.Fatalf("Failed to rotate journal, err %s", err)
+	}
+	journal.close()
+
+	// All the journaled votes are replayed into the pool
+	votePool := NewVotePool(chain, &mockPOSA{}, 22, path, time.Second)
+	votePool.mu.RLock()
+	defer votePool.mu.RUnlock()
+	if len(*votePool.futureVotesPq) != len(votes) {
+		t.Fatalf("Future vote pool length, expect %d have %d", len(votes), len(*votePool.futureVotesPq))
+	}
+}
+
+func TestVotePoolInvalidVoteEventUnlocked(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	if err != nil {
+		t.Fatalf("Failed to create secret key, err %s", err)
+	}
+
+	// Create a database pre-initialize with a genesis block
+	db := rawdb.NewMemoryDatabase()
+	genesis := (&core.Genesis{
+		Config:  params.TestChainConfig,
+		Alloc:   core.GenesisAlloc{testAddr: {Balance: big.NewInt(1000000)}},
+		BaseFee: big.NewInt(params.InitialBaseFee),
+	}).MustCommit(db)
+	chain, _ := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFullFaker(), vm.Config{}, nil, nil)
+
+	bs, _ := core.GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 1, nil, true)
+	if _, err := chain.InsertChain(bs[:1]); err != nil {
+		panic(err)
+	}
+	votePool := NewVotePool(chain, &mockPOSAv2{}, 22, "", 0)
+
+	// The event is not read until the pool is accessed, which must not wait
+	// for the event to be delivered
+	invalidVoteCh := make(chan core.InvalidVoteEvent)
+	sub := votePool.SubscribeInvalidVoteEvent(invalidVoteCh)
+	defer sub.Unsubscribe()
+
+	votePool.PutVote("AAAA", generateVote(0, bs[0].Hash(), secretKey))
+	time.Sleep(2 * voteBatchWindow)
+
+	done := make(chan struct{})
+	go func() {
+		votePool.GetVotes()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Vote pool is locked while sending invalid vote event")
+	}
+	select {
+	case ev := <-invalidVoteCh:
+		if ev.Peer != "AAAA" || !errors.Is(ev.Err, finality.ErrInvalidTargetNumber) {
+			t.Fatalf("Invalid vote event mismatch, expect %s %v have %s %v", "AAAA", finality.ErrInvalidTargetNumber, ev.Peer, ev.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Invalid vote event timeout")
+	}
+}
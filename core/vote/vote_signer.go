@@ -22,10 +22,11 @@ const (
 var votesSigningErrorCounter = metrics.NewRegisteredCounter("votesSigner/error", nil)
 
 type VoteSigner struct {
-	km     *wallet.KeyManager
+	km     wallet.Signer
 	pubKey [params.BLSPubkeyLength]byte
 }
 
+// NewVoteSigner creates a vote signer with the BLS key in local keystore.
 func NewVoteSigner(blsPasswordPath, blsWalletPath string) (*VoteSigner, error) {
 	w, err := wallet.New(blsWalletPath, blsPasswordPath)
 	if err != nil {
@@ -42,6 +43,23 @@ func NewVoteSigner(blsPasswordPath, blsWalletPath string) (*VoteSigner, error) {
 	}
 	log.Info("Initialized keymanager successfully")
 
+	return newVoteSigner(km)
+}
+
+// NewRemoteVoteSigner creates a vote signer which sends the vote digest to the
+// external signer at endpoint for signing.
+func NewRemoteVoteSigner(endpoint string) (*VoteSigner, error) {
+	remoteSigner, err := wallet.NewRemoteSigner(endpoint)
+	if err != nil {
+		log.Error("Failed to connect to remote BLS signer", "endpoint", endpoint, "err", err)
+		return nil, err
+	}
+	log.Info("Connected to remote BLS signer", "endpoint", endpoint)
+
+	return newVoteSigner(remoteSigner)
+}
+
+func newVoteSigner(km wallet.Signer) (*VoteSigner, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), voteSignerTimeout)
 	defer cancel()
 
@@ -73,7 +91,7 @@ func (signer *VoteSigner) SignVote(vote *types.VoteEnvelope) error {
 	ctx, cancel := context.WithTimeout(context.Background(), voteSignerTimeout)
 	defer cancel()
 
-	signature, err := (*signer.km).Sign(ctx, &wallet.SignRequest{
+	signature, err := signer.km.Sign(ctx, &wallet.SignRequest{
 		PublicKey:   pubKey[:],
 		SigningRoot: voteDataHash[:],
 	})
@@ -82,6 +82,14 @@ func (b *EthAPIBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumb
 			return nil, errors.New("header not found")
 		}
 	}
+	if number == rpc.SafeBlockNumber {
+		justifiedBlock := b.eth.blockchain.JustifiedBlock()
+		if justifiedBlock != nil {
+			return justifiedBlock.Header(), nil
+		} else {
+			return nil, errors.New("header not found")
+		}
+	}
 
 	return b.eth.blockchain.GetHeaderByNumber(uint64(number)), nil
 }
@@ -120,6 +128,9 @@ func (b *EthAPIBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumbe
 	if number == rpc.FinalizedBlockNumber {
 		return b.eth.blockchain.FinalizedBlock(), nil
 	}
+	if number == rpc.SafeBlockNumber {
+		return b.eth.blockchain.JustifiedBlock(), nil
+	}
 	return b.eth.blockchain.GetBlockByNumber(uint64(number)), nil
 }
 
@@ -303,6 +314,10 @@ func (b *EthAPIBackend) TxPoolContentFrom(addr common.Address) (types.Transactio
 	return b.eth.TxPool().ContentFrom(addr)
 }
 
+func (b *EthAPIBackend) TxPoolContentFromPayer(payer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	return b.eth.TxPool().ContentFromPayer(payer)
+}
+
 func (b *EthAPIBackend) TxPool() *core.TxPool {
 	return b.eth.TxPool()
 }
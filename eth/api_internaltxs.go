@@ -0,0 +1,197 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// maxInternalTxsResults is the maximum number of internal transactions
+	// returned by an address based query.
+	maxInternalTxsResults = 10000
+
+	// maxInternalTxsBlockRange is the maximum number of blocks scanned by an
+	// address based query.
+	maxInternalTxsBlockRange = 100000
+
+	// internalTxsIndexPage is the number of address index entries read at once
+	// by an address based query.
+	internalTxsIndexPage = maxInternalTxsResults + 1
+)
+
+var errInternalTxsNotStored = errors.New("internal transactions are not stored, restart with --internaltxs")
+
+// PublicInternalTxAPI provides an API to query the internal transactions stored
+// with --internaltxs.
+type PublicInternalTxAPI struct {
+	eth *Ethereum
+}
+
+// NewPublicInternalTxAPI creates a new internal transaction query API.
+func NewPublicInternalTxAPI(eth *Ethereum) *PublicInternalTxAPI {
+	return &PublicInternalTxAPI{eth: eth}
+}
+
+// GetInternalTransactionsByTxHash returns the internal transactions created by
+// the transaction with the given hash.
+func (api *PublicInternalTxAPI) GetInternalTransactionsByTxHash(ctx context.Context, hash common.Hash) ([]*ethapi.RPCInternalTransaction, error) {
+	tx, blockHash, _, _ := rawdb.ReadTransaction(api.eth.ChainDb(), hash)
+	if tx == nil {
+		return nil, nil
+	}
+	result := make([]*ethapi.RPCInternalTransaction, 0)
+	for _, internalTx := range api.eth.blockchain.ReadInternalTransactions(blockHash) {
+		if internalTx.TransactionHash == hash {
+			result = append(result, ethapi.NewRPCInternalTransaction(internalTx))
+		}
+	}
+	return result, nil
+}
+
+// GetInternalTransactionsByBlockNumber returns the internal transactions in the
+// block with the given number.
+func (api *PublicInternalTxAPI) GetInternalTransactionsByBlockNumber(ctx context.Context, number rpc.BlockNumber) ([]*ethapi.RPCInternalTransaction, error) {
+	header, err := api.eth.APIBackend.HeaderByNumber(ctx, number)
+	if header == nil || err != nil {
+		return nil, err
+	}
+	return api.blockInternalTransactions(header.Hash()), nil
+}
+
+// GetInternalTransactionsByBlockHash returns the internal transactions in the
+// block with the given hash.
+func (api *PublicInternalTxAPI) GetInternalTransactionsByBlockHash(ctx context.Context, hash common.Hash) ([]*ethapi.RPCInternalTransaction, error) {
+	if header := api.eth.blockchain.GetHeaderByHash(hash); header == nil {
+		return nil, nil
+	}
+	return api.blockInternalTransactions(hash), nil
+}
+
+func (api *PublicInternalTxAPI) blockInternalTransactions(hash common.Hash) []*ethapi.RPCInternalTransaction {
+	internalTxs := api.eth.blockchain.ReadInternalTransactions(hash)
+	result := make([]*ethapi.RPCInternalTransaction, 0, len(internalTxs))
+	for _, internalTx := range internalTxs {
+		result = append(result, ethapi.NewRPCInternalTransaction(internalTx))
+	}
+	return result
+}
+
+// InternalTxFilterCriteria is the arguments of an address based internal
+// transaction query, at least one of From and To must be specified. The block
+// range defaults to the latest block.
+type InternalTxFilterCriteria struct {
+	FromBlock *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber `json:"toBlock"`
+	From      *common.Address  `json:"from"`
+	To        *common.Address  `json:"to"`
+}
+
+// GetInternalTransactions returns the canonical internal transactions matching
+// the filter criteria, ordered by block and execution order.
+func (api *PublicInternalTxAPI) GetInternalTransactions(ctx context.Context, crit InternalTxFilterCriteria) ([]*ethapi.RPCInternalTransaction, error) {
+	if crit.From == nil && crit.To == nil {
+		return nil, errors.New("either from or to address must be specified")
+	}
+	if !rawdb.ReadStoreInternalTransactionsEnabled(api.eth.ChainDb()) {
+		return nil, errInternalTxsNotStored
+	}
+	begin, err := api.resolveBlockNumber(ctx, crit.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+	end, err := api.resolveBlockNumber(ctx, crit.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+	if begin > end {
+		return nil, fmt.Errorf("invalid block range %d - %d", begin, end)
+	}
+	if end-begin >= maxInternalTxsBlockRange {
+		return nil, fmt.Errorf("block range %d - %d exceeds %d blocks", begin, end, maxInternalTxsBlockRange)
+	}
+
+	// Query the index with the address having less matching blocks is better,
+	// just prefer the from address.
+	address := crit.To
+	if crit.From != nil {
+		address = crit.From
+	}
+	var (
+		db     = api.eth.ChainDb()
+		result = make([]*ethapi.RPCInternalTransaction, 0)
+		next   = begin
+		last   *rawdb.NumberHash
+	)
+	for next <= end {
+		blocks := rawdb.ReadInternalTxAddressIndex(db, *address, next, end, internalTxsIndexPage)
+		for _, block := range blocks {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			// Skip the entries already read in the previous page
+			if last != nil && block.Number == last.Number && bytes.Compare(block.Hash[:], last.Hash[:]) <= 0 {
+				continue
+			}
+			last = block
+
+			// The index is not cleaned up on reorg, skip the non-canonical blocks
+			if rawdb.ReadCanonicalHash(db, block.Number) != block.Hash {
+				continue
+			}
+			for _, internalTx := range api.eth.blockchain.ReadInternalTransactions(block.Hash) {
+				if !matchInternalTx(internalTx, crit.From, crit.To) {
+					continue
+				}
+				if len(result) >= maxInternalTxsResults {
+					return nil, fmt.Errorf("query returned more than %d results", maxInternalTxsResults)
+				}
+				result = append(result, ethapi.NewRPCInternalTransaction(internalTx))
+			}
+		}
+		if len(blocks) < internalTxsIndexPage {
+			break
+		}
+		// The page may end in the middle of the blocks of a number, resume
+		// from that number unless the whole page is at the same number.
+		if next = blocks[len(blocks)-1].Number; next == blocks[0].Number {
+			next++
+		}
+	}
+	return result, nil
+}
+
+func (api *PublicInternalTxAPI) resolveBlockNumber(ctx context.Context, number *rpc.BlockNumber) (uint64, error) {
+	blockNr := rpc.LatestBlockNumber
+	if number != nil {
+		blockNr = *number
+	}
+	if blockNr >= 0 {
+		return uint64(blockNr), nil
+	}
+	header, err := api.eth.APIBackend.HeaderByNumber(ctx, blockNr)
+	if err != nil {
+		return 0, err
+	}
+	if header == nil {
+		return 0, errors.New("header not found")
+	}
+	return header.Number.Uint64(), nil
+}
+
+func matchInternalTx(internalTx *types.InternalTransaction, from, to *common.Address) bool {
+	if from != nil && internalTx.From != *from {
+		return false
+	}
+	if to != nil && internalTx.To != *to {
+		return false
+	}
+	return true
+}
@@ -53,6 +53,7 @@ import (
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/monitor"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
@@ -90,9 +91,10 @@ type Ethereum struct {
 
 	APIBackend *EthAPIBackend
 
-	miner     *miner.Miner
-	gasPrice  *big.Int
-	etherbase common.Address
+	miner             *miner.Miner
+	slashingSubmitter *monitor.SlashingSubmitter
+	gasPrice          *big.Int
+	etherbase         common.Address
 
 	networkID     uint64
 	netRPCService *ethapi.PublicNetAPI
@@ -203,15 +205,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
-	if config.EnableMonitorDoubleSign {
-		go eth.blockchain.StartDoubleSignMonitor()
-	}
 	if config.EnableAdditionalChainEvent {
 		eth.blockchain.EnableAdditionalChainEvent()
 	}
-	if config.EnableMonitorFinalityVote {
-		go eth.blockchain.StartFinalityVoteMonitor()
-	}
 
 	// Rewind the chain in case of an incompatible config upgrade.
 	if compat, ok := genesisErr.(*params.ConfigCompatError); ok {
@@ -226,6 +222,36 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	}
 	eth.txPool = core.NewTxPool(config.TxPool, chainConfig, eth.blockchain)
 
+	if config.EnableDoubleSignSlashing || config.EnableFinalityVoteSlashing {
+		eth.slashingSubmitter, err = monitor.NewSlashingSubmitter(chainConfig, eth.txPool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var alerter monitor.Alerter
+	if config.EnableMonitorDoubleSign || config.EnableMonitorFinalityVote {
+		if alerter, err = monitor.NewAlerter(&config.MonitorAlert); err != nil {
+			return nil, err
+		}
+	}
+	if config.EnableMonitorDoubleSign {
+		var submitter *monitor.SlashingSubmitter
+		if config.EnableDoubleSignSlashing {
+			submitter = eth.slashingSubmitter
+		}
+		go eth.blockchain.StartDoubleSignMonitor(alerter, submitter)
+	}
+	if config.EnableMonitorValidatorLiveness {
+		go eth.blockchain.StartValidatorLivenessMonitor()
+	}
+	if config.EnableMonitorFinalityVote {
+		var submitter *monitor.SlashingSubmitter
+		if config.EnableFinalityVoteSlashing {
+			submitter = eth.slashingSubmitter
+		}
+		go eth.blockchain.StartFinalityVoteMonitor(alerter, submitter)
+	}
+
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieCleanLimit + cacheConfig.TrieDirtyLimit + cacheConfig.SnapshotLimit
 	checkpoint := config.Checkpoint
@@ -243,7 +269,17 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		if !ok {
 			return nil, errors.New("consensus engine does not support fast finality")
 		}
-		votePool = vote.NewVotePool(eth.blockchain, finalityEngine, nodeConfig.MaxCurVoteAmountPerBlock)
+		var voteJournal string
+		if nodeConfig.VoteJournal != "" {
+			voteJournal = stack.ResolvePath(nodeConfig.VoteJournal)
+		}
+		votePool = vote.NewVotePool(
+			eth.blockchain,
+			finalityEngine,
+			nodeConfig.MaxCurVoteAmountPerBlock,
+			voteJournal,
+			nodeConfig.VoteRejournal,
+		)
 
 		if _, err := vote.NewVoteManager(
 			eth,
@@ -254,6 +290,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			nodeConfig.EnableFastFinalitySign,
 			nodeConfig.BlsPasswordPath,
 			nodeConfig.BlsWalletPath,
+			nodeConfig.BlsRemoteSigner,
 			finalityEngine,
 			nil,
 		); err != nil {
@@ -377,6 +414,15 @@ func (s *Ethereum) APIs() []rpc.API {
 	// Append any APIs exposed explicitly by the consensus engine
 	apis = append(apis, s.engine.APIs(s.BlockChain())...)
 
+	if s.config.EnableMonitorDoubleSign {
+		apis = append(apis, rpc.API{
+			Namespace: "monitor",
+			Version:   "1.0",
+			Service:   monitor.NewPublicMonitorAPI(s.chainDb),
+			Public:    true,
+		})
+	}
+
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
 		{
@@ -404,6 +450,11 @@ func (s *Ethereum) APIs() []rpc.API {
 			Version:   "1.0",
 			Service:   filters.NewPublicFilterAPI(s.APIBackend, false, 5*time.Minute),
 			Public:    true,
+		}, {
+			Namespace: "eth",
+			Version:   "1.0",
+			Service:   NewPublicInternalTxAPI(s),
+			Public:    true,
 		}, {
 			Namespace: "admin",
 			Version:   "1.0",
@@ -563,6 +614,9 @@ func (s *Ethereum) StartMining(threads int) error {
 				return fmt.Errorf("signer missing: %v", err)
 			}
 			consortium.Authorize(eb, wallet.SignData, wallet.SignTx)
+			if s.slashingSubmitter != nil {
+				s.slashingSubmitter.Authorize(eb, wallet.SignTx)
+			}
 		}
 		// If mining is started, we can disable the transaction rejection mechanism
 		// introduced to speed sync times.
@@ -635,6 +689,10 @@ func (s *Ethereum) Start() error {
 	}
 	// Start the networking layer and the light server if requested
 	s.handler.Start(maxPeers)
+
+	if s.slashingSubmitter != nil {
+		s.slashingSubmitter.Start()
+	}
 	return nil
 }
 
@@ -647,6 +705,9 @@ func (s *Ethereum) Stop() error {
 	s.handler.Stop()
 
 	// Then stop everything else.
+	if s.slashingSubmitter != nil {
+		s.slashingSubmitter.Stop()
+	}
 	s.bloomIndexer.Close()
 	close(s.closeBloomHandler)
 	s.txPool.Stop()
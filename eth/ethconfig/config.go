@@ -38,6 +38,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/monitor"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -92,11 +93,16 @@ var Defaults = Config{
 		BlockProduceLeftOver: 200 * time.Millisecond,
 		BlockSizeReserve:     500000,
 	},
-	TxPool:        core.DefaultTxPoolConfig,
-	RPCGasCap:     50000000,
-	RPCEVMTimeout: 5 * time.Second,
-	GPO:           FullNodeGPO,
-	RPCTxFeeCap:   1, // 1 ether
+	TxPool:           core.DefaultTxPoolConfig,
+	RPCGasCap:        50000000,
+	RPCEVMTimeout:    5 * time.Second,
+	GPO:              FullNodeGPO,
+	RPCTxFeeCap:      1, // 1 ether
+	TraceCacheBlocks: 128,
+	MonitorAlert: monitor.AlertConfig{
+		RateLimit:   monitor.DefaultAlertRateLimit,
+		DedupWindow: monitor.DefaultAlertDedupWindow,
+	},
 }
 
 func init() {
@@ -202,6 +208,14 @@ type Config struct {
 	// send-transction variants. The unit is ether.
 	RPCTxFeeCap float64
 
+	// TraceCacheTracers are the tracers run on the imported blocks, whose
+	// results are served by the debug tracing APIs without re-execution.
+	TraceCacheTracers []string `toml:",omitempty"`
+
+	// TraceCacheBlocks is the number of recent blocks whose trace results are
+	// cached. The trace cache is enabled only if some tracers are configured.
+	TraceCacheBlocks uint64 `toml:",omitempty"`
+
 	// Checkpoint is a hardcoded checkpoint which can be nil.
 	Checkpoint *params.TrustedCheckpoint `toml:",omitempty"`
 
@@ -214,9 +228,23 @@ type Config struct {
 	// Enable double sign monitoring
 	EnableMonitorDoubleSign bool
 
+	// Submit the double sign evidences found by double sign monitor to
+	// SlashIndicator contract
+	EnableDoubleSignSlashing bool
+
 	// Enable finality vote monitoring
 	EnableMonitorFinalityVote bool
 
+	// Submit the finality vote violations found by finality vote monitor to
+	// SlashIndicator contract
+	EnableFinalityVoteSlashing bool
+
+	// Enable validator block production and finality voting monitoring
+	EnableMonitorValidatorLiveness bool
+
+	// Alert sinks of double sign and finality vote monitors
+	MonitorAlert monitor.AlertConfig
+
 	// Disable ronin p2p protocol
 	DisableRoninProtocol bool
 
@@ -32,18 +32,20 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // filter is a helper struct that holds meta information over the filter type
 // and associated subscription in the event system.
 type filter struct {
-	typ      Type
-	deadline *time.Timer // filter is inactiv when deadline triggers
-	hashes   []common.Hash
-	crit     FilterCriteria
-	logs     []*types.Log
-	s        *Subscription // associated subscription in event system
+	typ         Type
+	deadline    *time.Timer // filter is inactiv when deadline triggers
+	hashes      []common.Hash
+	crit        FilterCriteria
+	logs        []*types.Log
+	internalTxs []*types.InternalTransaction
+	s           *Subscription // associated subscription in event system
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -240,6 +242,36 @@ func (api *PublicFilterAPI) NewFinalizedBlocks(ctx context.Context) (*rpc.Subscr
 	return rpcSub, nil
 }
 
+// NewJustifiedBlocks send a notification each time the justified block of the
+// chain head changes.
+func (api *PublicFilterAPI) NewJustifiedBlocks(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	go func() {
+		justifier := make(chan *core.JustifiedBlockInfo)
+		jSub := api.events.SubscribeNewJustifiedBlocks(justifier)
+
+		for {
+			select {
+			case j := <-justifier:
+				notifier.Notify(rpcSub.ID, j)
+			case <-rpcSub.Err():
+				jSub.Unsubscribe()
+				return
+			case <-notifier.Closed():
+				jSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 // NewHeads send a notification each time a new (header) block is appended to the chain.
 func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
@@ -308,6 +340,73 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit FilterCriteria) (*rpc
 	return rpcSub, nil
 }
 
+// InternalTransactions creates a subscription that fires for all new internal
+// transactions that match the given criteria.
+func (api *PublicFilterAPI) InternalTransactions(ctx context.Context, crit InternalTxCriteria) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		internalTxs := make(chan []*types.InternalTransaction)
+		internalTxsSub := api.events.SubscribeInternalTransactions(crit, internalTxs)
+
+		for {
+			select {
+			case txs := <-internalTxs:
+				for _, tx := range txs {
+					notifier.Notify(rpcSub.ID, ethapi.NewRPCInternalTransaction(tx))
+				}
+			case <-rpcSub.Err():
+				internalTxsSub.Unsubscribe()
+				return
+			case <-notifier.Closed():
+				internalTxsSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// NewInternalTransactionFilter creates a filter that fetches the internal
+// transactions of new blocks matching the given criteria. The internal
+// transactions can be retrieved through eth_getFilterChanges.
+func (api *PublicFilterAPI) NewInternalTransactionFilter(crit InternalTxCriteria) rpc.ID {
+	var (
+		internalTxs    = make(chan []*types.InternalTransaction)
+		internalTxsSub = api.events.SubscribeInternalTransactions(crit, internalTxs)
+	)
+
+	api.filtersMu.Lock()
+	api.filters[internalTxsSub.ID] = &filter{typ: InternalTransactionsSubscription, deadline: time.NewTimer(api.timeout), s: internalTxsSub}
+	api.filtersMu.Unlock()
+
+	go func() {
+		for {
+			select {
+			case txs := <-internalTxs:
+				api.filtersMu.Lock()
+				if f, found := api.filters[internalTxsSub.ID]; found {
+					f.internalTxs = append(f.internalTxs, txs...)
+				}
+				api.filtersMu.Unlock()
+			case <-internalTxsSub.Err():
+				api.filtersMu.Lock()
+				delete(api.filters, internalTxsSub.ID)
+				api.filtersMu.Unlock()
+				return
+			}
+		}
+	}()
+
+	return internalTxsSub.ID
+}
+
 // FilterCriteria represents a request to create a new filter.
 // Same as ethereum.FilterQuery but with UnmarshalJSON() method.
 type FilterCriteria ethereum.FilterQuery
@@ -446,6 +545,7 @@ func (api *PublicFilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*ty
 //
 // For pending transaction and block filters the result is []common.Hash.
 // (pending)Log filters return []Log.
+// Internal transaction filters return []RPCInternalTransaction.
 //
 // https://eth.wiki/json-rpc/API#eth_getfilterchanges
 func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
@@ -469,6 +569,13 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 			logs := f.logs
 			f.logs = nil
 			return returnLogs(logs), nil
+		case InternalTransactionsSubscription:
+			internalTxs := make([]*ethapi.RPCInternalTransaction, 0, len(f.internalTxs))
+			for _, tx := range f.internalTxs {
+				internalTxs = append(internalTxs, ethapi.NewRPCInternalTransaction(tx))
+			}
+			f.internalTxs = nil
+			return internalTxs, nil
 		}
 	}
 
@@ -46,6 +46,7 @@ type Backend interface {
 	SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent) event.Subscription
 	SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription
 	SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription
+	SubscribeInternalTransactionEvent(ch chan<- []*types.InternalTransaction) event.Subscription
 
 	BloomStatus() (uint64, uint64)
 	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
@@ -154,13 +155,31 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	}
 	head := header.Number.Uint64()
 
-	if f.begin == -1 {
-		f.begin = int64(head)
+	resolve := func(number int64) (int64, error) {
+		switch rpc.BlockNumber(number) {
+		case rpc.LatestBlockNumber:
+			return int64(head), nil
+		case rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+			header, err := f.backend.HeaderByNumber(ctx, rpc.BlockNumber(number))
+			if err != nil {
+				return 0, err
+			}
+			if header == nil {
+				return 0, errors.New("header not found")
+			}
+			return header.Number.Int64(), nil
+		}
+		return number, nil
 	}
-	end := uint64(f.end)
-	if f.end == -1 {
-		end = head
+	var err error
+	if f.begin, err = resolve(f.begin); err != nil {
+		return nil, err
 	}
+	resolvedEnd, err := resolve(f.end)
+	if err != nil {
+		return nil, err
+	}
+	end := uint64(resolvedEnd)
 	if end-uint64(f.begin) > blockRangeLimit {
 		log.Info(
 			"Filter block range is higher than the limit",
@@ -169,10 +188,7 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 		return nil, errors.New("filter block range is higher than the limit")
 	}
 	// Gather all indexed logs, and finish with non indexed ones
-	var (
-		logs []*types.Log
-		err  error
-	)
+	var logs []*types.Log
 	size, sections := f.backend.BloomStatus()
 	if indexed := sections * size; indexed > uint64(f.begin) {
 		if indexed > end {
@@ -21,6 +21,7 @@ package filters
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -53,8 +54,14 @@ const (
 	PendingTransactionsSubscription
 	// BlocksSubscription queries hashes for blocks that are imported
 	BlocksSubscription
-	// FinalizedBlockSubscription
+	// FinalizedBlockSubscription queries the finalized blocks of new heads
 	FinalizedBlockSubscription
+	// JustifiedBlockSubscription queries the justified blocks of new heads
+	JustifiedBlockSubscription
+	// FinalizedLogsSubscription queries for logs once their blocks are finalized
+	FinalizedLogsSubscription
+	// InternalTransactionsSubscription queries for internal transactions of new blocks
+	InternalTransactionsSubscription
 	// LastSubscription keeps track of the last index
 	LastIndexSubscription
 )
@@ -69,19 +76,37 @@ const (
 	logsChanSize = 10
 	// chainEvChanSize is the size of channel listening to ChainEvent.
 	chainEvChanSize = 10
+	// internalTxsChanSize is the size of channel listening to internal transaction event.
+	internalTxsChanSize = 10
+	// maxFinalizedLogsRange is the maximum number of newly finalized blocks whose
+	// logs are delivered to the finalized logs subscriptions at once.
+	maxFinalizedLogsRange = 1024
+	// finalizedLogsBatch is the maximum number of finalized blocks whose logs are
+	// read in one iteration of the event loop.
+	finalizedLogsBatch = 16
 )
 
+// closedChan is a closed channel, selecting it never blocks.
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
 type subscription struct {
-	id         rpc.ID
-	typ        Type
-	created    time.Time
-	logsCrit   ethereum.FilterQuery
-	logs       chan []*types.Log
-	hashes     chan []common.Hash
-	headers    chan *types.Header
-	finalizers chan *core.FinalizedBlockInfo
-	installed  chan struct{} // closed when the filter is installed
-	err        chan error    // closed when the filter is uninstalled
+	id              rpc.ID
+	typ             Type
+	created         time.Time
+	logsCrit        ethereum.FilterQuery
+	internalTxsCrit InternalTxCriteria
+	logs            chan []*types.Log
+	hashes          chan []common.Hash
+	headers         chan *types.Header
+	finalizers      chan *core.FinalizedBlockInfo
+	justifiers      chan *core.JustifiedBlockInfo
+	internalTxs     chan []*types.InternalTransaction
+	installed       chan struct{} // closed when the filter is installed
+	err             chan error    // closed when the filter is uninstalled
 }
 
 // EventSystem creates subscriptions, processes events and broadcasts them to the
@@ -91,6 +116,11 @@ type EventSystem struct {
 	lightMode     bool
 	lastHead      *types.Header
 	lastFinalized uint64 // last finalized block number
+	lastJustified uint64 // last justified block number
+
+	// Next finalized block whose logs are to be delivered, the logs of the
+	// blocks up to lastFinalized are pending when it's not zero
+	nextFinalizedLogs uint64
 
 	// Subscriptions
 	txsSub         event.Subscription // Subscription for new transaction event
@@ -98,15 +128,17 @@ type EventSystem struct {
 	rmLogsSub      event.Subscription // Subscription for removed log event
 	pendingLogsSub event.Subscription // Subscription for pending log event
 	chainSub       event.Subscription // Subscription for new chain event
+	internalTxsSub event.Subscription // Subscription for internal transaction event
 
 	// Channels
-	install       chan *subscription         // install filter for event notification
-	uninstall     chan *subscription         // remove filter for event notification
-	txsCh         chan core.NewTxsEvent      // Channel to receive new transactions event
-	logsCh        chan []*types.Log          // Channel to receive new log event
-	pendingLogsCh chan []*types.Log          // Channel to receive new log event
-	rmLogsCh      chan core.RemovedLogsEvent // Channel to receive removed log event
-	chainCh       chan core.ChainEvent       // Channel to receive new chain event
+	install       chan *subscription                // install filter for event notification
+	uninstall     chan *subscription                // remove filter for event notification
+	txsCh         chan core.NewTxsEvent             // Channel to receive new transactions event
+	logsCh        chan []*types.Log                 // Channel to receive new log event
+	pendingLogsCh chan []*types.Log                 // Channel to receive new log event
+	rmLogsCh      chan core.RemovedLogsEvent        // Channel to receive removed log event
+	chainCh       chan core.ChainEvent              // Channel to receive new chain event
+	internalTxsCh chan []*types.InternalTransaction // Channel to receive internal transaction event
 }
 
 // NewEventSystem creates a new manager that listens for event on the given mux,
@@ -126,6 +158,7 @@ func NewEventSystem(backend Backend, lightMode bool) *EventSystem {
 		rmLogsCh:      make(chan core.RemovedLogsEvent, rmLogsChanSize),
 		pendingLogsCh: make(chan []*types.Log, logsChanSize),
 		chainCh:       make(chan core.ChainEvent, chainEvChanSize),
+		internalTxsCh: make(chan []*types.InternalTransaction, internalTxsChanSize),
 	}
 
 	// Subscribe events
@@ -134,9 +167,11 @@ func NewEventSystem(backend Backend, lightMode bool) *EventSystem {
 	m.rmLogsSub = m.backend.SubscribeRemovedLogsEvent(m.rmLogsCh)
 	m.chainSub = m.backend.SubscribeChainEvent(m.chainCh)
 	m.pendingLogsSub = m.backend.SubscribePendingLogsEvent(m.pendingLogsCh)
+	m.internalTxsSub = m.backend.SubscribeInternalTransactionEvent(m.internalTxsCh)
 
 	// Make sure none of the subscriptions are empty
-	if m.txsSub == nil || m.logsSub == nil || m.rmLogsSub == nil || m.chainSub == nil || m.pendingLogsSub == nil {
+	if m.txsSub == nil || m.logsSub == nil || m.rmLogsSub == nil || m.chainSub == nil || m.pendingLogsSub == nil ||
+		m.internalTxsSub == nil {
 		log.Crit("Subscribe for event system failed")
 	}
 
@@ -172,6 +207,9 @@ func (sub *Subscription) Unsubscribe() {
 			case <-sub.f.logs:
 			case <-sub.f.hashes:
 			case <-sub.f.headers:
+			case <-sub.f.finalizers:
+			case <-sub.f.justifiers:
+			case <-sub.f.internalTxs:
 			}
 		}
 
@@ -205,6 +243,12 @@ func (es *EventSystem) SubscribeLogs(crit ethereum.FilterQuery, logs chan []*typ
 		to = rpc.BlockNumber(crit.ToBlock.Int64())
 	}
 
+	// only interested in logs of newly finalized blocks
+	if (from == rpc.FinalizedBlockNumber || from == rpc.LatestBlockNumber) &&
+		(to == rpc.FinalizedBlockNumber || to == rpc.LatestBlockNumber) &&
+		(from == rpc.FinalizedBlockNumber || to == rpc.FinalizedBlockNumber) {
+		return es.subscribeFinalizedLogs(crit, logs), nil
+	}
 	// only interested in pending logs
 	if from == rpc.PendingBlockNumber && to == rpc.PendingBlockNumber {
 		return es.subscribePendingLogs(crit, logs), nil
@@ -232,16 +276,18 @@ func (es *EventSystem) SubscribeLogs(crit ethereum.FilterQuery, logs chan []*typ
 // pending logs that match the given criteria.
 func (es *EventSystem) subscribeMinedPendingLogs(crit ethereum.FilterQuery, logs chan []*types.Log) *Subscription {
 	sub := &subscription{
-		id:         rpc.NewID(),
-		typ:        MinedAndPendingLogsSubscription,
-		logsCrit:   crit,
-		created:    time.Now(),
-		logs:       logs,
-		hashes:     make(chan []common.Hash),
-		headers:    make(chan *types.Header),
-		finalizers: make(chan *core.FinalizedBlockInfo),
-		installed:  make(chan struct{}),
-		err:        make(chan error),
+		id:          rpc.NewID(),
+		typ:         MinedAndPendingLogsSubscription,
+		logsCrit:    crit,
+		created:     time.Now(),
+		logs:        logs,
+		hashes:      make(chan []common.Hash),
+		headers:     make(chan *types.Header),
+		finalizers:  make(chan *core.FinalizedBlockInfo),
+		justifiers:  make(chan *core.JustifiedBlockInfo),
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
 	}
 	return es.subscribe(sub)
 }
@@ -250,16 +296,39 @@ func (es *EventSystem) subscribeMinedPendingLogs(crit ethereum.FilterQuery, logs
 // given criteria to the given logs channel.
 func (es *EventSystem) subscribeLogs(crit ethereum.FilterQuery, logs chan []*types.Log) *Subscription {
 	sub := &subscription{
-		id:         rpc.NewID(),
-		typ:        LogsSubscription,
-		logsCrit:   crit,
-		created:    time.Now(),
-		logs:       logs,
-		hashes:     make(chan []common.Hash),
-		headers:    make(chan *types.Header),
-		finalizers: make(chan *core.FinalizedBlockInfo),
-		installed:  make(chan struct{}),
-		err:        make(chan error),
+		id:          rpc.NewID(),
+		typ:         LogsSubscription,
+		logsCrit:    crit,
+		created:     time.Now(),
+		logs:        logs,
+		hashes:      make(chan []common.Hash),
+		headers:     make(chan *types.Header),
+		finalizers:  make(chan *core.FinalizedBlockInfo),
+		justifiers:  make(chan *core.JustifiedBlockInfo),
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
+	}
+	return es.subscribe(sub)
+}
+
+// subscribeFinalizedLogs creates a subscription that will write all logs
+// matching the given criteria to the given logs channel once their blocks are
+// finalized.
+func (es *EventSystem) subscribeFinalizedLogs(crit ethereum.FilterQuery, logs chan []*types.Log) *Subscription {
+	sub := &subscription{
+		id:          rpc.NewID(),
+		typ:         FinalizedLogsSubscription,
+		logsCrit:    crit,
+		created:     time.Now(),
+		logs:        logs,
+		hashes:      make(chan []common.Hash),
+		headers:     make(chan *types.Header),
+		finalizers:  make(chan *core.FinalizedBlockInfo),
+		justifiers:  make(chan *core.JustifiedBlockInfo),
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
 	}
 	return es.subscribe(sub)
 }
@@ -268,16 +337,18 @@ func (es *EventSystem) subscribeLogs(crit ethereum.FilterQuery, logs chan []*typ
 // transactions that enter the transaction pool.
 func (es *EventSystem) subscribePendingLogs(crit ethereum.FilterQuery, logs chan []*types.Log) *Subscription {
 	sub := &subscription{
-		id:         rpc.NewID(),
-		typ:        PendingLogsSubscription,
-		logsCrit:   crit,
-		created:    time.Now(),
-		logs:       logs,
-		hashes:     make(chan []common.Hash),
-		headers:    make(chan *types.Header),
-		finalizers: make(chan *core.FinalizedBlockInfo),
-		installed:  make(chan struct{}),
-		err:        make(chan error),
+		id:          rpc.NewID(),
+		typ:         PendingLogsSubscription,
+		logsCrit:    crit,
+		created:     time.Now(),
+		logs:        logs,
+		hashes:      make(chan []common.Hash),
+		headers:     make(chan *types.Header),
+		finalizers:  make(chan *core.FinalizedBlockInfo),
+		justifiers:  make(chan *core.JustifiedBlockInfo),
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
 	}
 	return es.subscribe(sub)
 }
@@ -286,15 +357,17 @@ func (es *EventSystem) subscribePendingLogs(crit ethereum.FilterQuery, logs chan
 // imported in the chain.
 func (es *EventSystem) SubscribeNewFinalizedBlocks(finalizers chan *core.FinalizedBlockInfo) *Subscription {
 	sub := &subscription{
-		id:         rpc.NewID(),
-		typ:        FinalizedBlockSubscription,
-		created:    time.Now(),
-		logs:       make(chan []*types.Log),
-		hashes:     make(chan []common.Hash),
-		headers:    make(chan *types.Header),
-		finalizers: finalizers,
-		installed:  make(chan struct{}),
-		err:        make(chan error),
+		id:          rpc.NewID(),
+		typ:         FinalizedBlockSubscription,
+		created:     time.Now(),
+		logs:        make(chan []*types.Log),
+		hashes:      make(chan []common.Hash),
+		headers:     make(chan *types.Header),
+		finalizers:  finalizers,
+		justifiers:  make(chan *core.JustifiedBlockInfo),
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
 	}
 	return es.subscribe(sub)
 }
@@ -303,15 +376,65 @@ func (es *EventSystem) SubscribeNewFinalizedBlocks(finalizers chan *core.Finaliz
 // imported in the chain.
 func (es *EventSystem) SubscribeNewHeads(headers chan *types.Header) *Subscription {
 	sub := &subscription{
-		id:         rpc.NewID(),
-		typ:        BlocksSubscription,
-		created:    time.Now(),
-		logs:       make(chan []*types.Log),
-		hashes:     make(chan []common.Hash),
-		headers:    headers,
-		finalizers: make(chan *core.FinalizedBlockInfo),
-		installed:  make(chan struct{}),
-		err:        make(chan error),
+		id:          rpc.NewID(),
+		typ:         BlocksSubscription,
+		created:     time.Now(),
+		logs:        make(chan []*types.Log),
+		hashes:      make(chan []common.Hash),
+		headers:     headers,
+		finalizers:  make(chan *core.FinalizedBlockInfo),
+		justifiers:  make(chan *core.JustifiedBlockInfo),
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
+	}
+	return es.subscribe(sub)
+}
+
+// SubscribeNewJustifiedBlocks creates a subscription that writes the justified
+// block when the justified block of the chain head changes.
+func (es *EventSystem) SubscribeNewJustifiedBlocks(justifiers chan *core.JustifiedBlockInfo) *Subscription {
+	sub := &subscription{
+		id:          rpc.NewID(),
+		typ:         JustifiedBlockSubscription,
+		created:     time.Now(),
+		logs:        make(chan []*types.Log),
+		hashes:      make(chan []common.Hash),
+		headers:     make(chan *types.Header),
+		finalizers:  make(chan *core.FinalizedBlockInfo),
+		justifiers:  justifiers,
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
+	}
+	return es.subscribe(sub)
+}
+
+// InternalTxCriteria is the criteria of internal transactions subscription, the
+// empty fields match all internal transactions.
+type InternalTxCriteria struct {
+	From     []common.Address `json:"from"`     // Matches the internal transactions from one of the addresses
+	To       []common.Address `json:"to"`       // Matches the internal transactions to one of the addresses
+	Opcodes  []string         `json:"opcodes"`  // Matches the internal transactions created by one of the opcodes, e.g. CALL, CREATE
+	MinValue *hexutil.Big     `json:"minValue"` // Matches the internal transactions transferring at least this value
+}
+
+// SubscribeInternalTransactions creates a subscription that writes the internal
+// transactions of new blocks which match the given criteria.
+func (es *EventSystem) SubscribeInternalTransactions(crit InternalTxCriteria, internalTxs chan []*types.InternalTransaction) *Subscription {
+	sub := &subscription{
+		id:              rpc.NewID(),
+		typ:             InternalTransactionsSubscription,
+		internalTxsCrit: crit,
+		created:         time.Now(),
+		logs:            make(chan []*types.Log),
+		hashes:          make(chan []common.Hash),
+		headers:         make(chan *types.Header),
+		finalizers:      make(chan *core.FinalizedBlockInfo),
+		justifiers:      make(chan *core.JustifiedBlockInfo),
+		internalTxs:     internalTxs,
+		installed:       make(chan struct{}),
+		err:             make(chan error),
 	}
 	return es.subscribe(sub)
 }
@@ -320,15 +443,17 @@ func (es *EventSystem) SubscribeNewHeads(headers chan *types.Header) *Subscripti
 // transactions that enter the transaction pool.
 func (es *EventSystem) SubscribePendingTxs(hashes chan []common.Hash) *Subscription {
 	sub := &subscription{
-		id:         rpc.NewID(),
-		typ:        PendingTransactionsSubscription,
-		created:    time.Now(),
-		logs:       make(chan []*types.Log),
-		hashes:     hashes,
-		headers:    make(chan *types.Header),
-		finalizers: make(chan *core.FinalizedBlockInfo),
-		installed:  make(chan struct{}),
-		err:        make(chan error),
+		id:          rpc.NewID(),
+		typ:         PendingTransactionsSubscription,
+		created:     time.Now(),
+		logs:        make(chan []*types.Log),
+		hashes:      hashes,
+		headers:     make(chan *types.Header),
+		finalizers:  make(chan *core.FinalizedBlockInfo),
+		justifiers:  make(chan *core.JustifiedBlockInfo),
+		internalTxs: make(chan []*types.InternalTransaction),
+		installed:   make(chan struct{}),
+		err:         make(chan error),
 	}
 	return es.subscribe(sub)
 }
@@ -382,6 +507,7 @@ func (es *EventSystem) handleFinalizedEvent(filters filterIndex, ev core.ChainEv
 	if ev.FinalizedBlockNumber == 0 || es.lastFinalized == ev.FinalizedBlockNumber {
 		return
 	}
+	lastFinalized := es.lastFinalized
 	es.lastFinalized = ev.FinalizedBlockNumber
 	for _, f := range filters[FinalizedBlockSubscription] {
 		f.finalizers <- &core.FinalizedBlockInfo{
@@ -389,6 +515,125 @@ func (es *EventSystem) handleFinalizedEvent(filters filterIndex, ev core.ChainEv
 			FinalizedBlockHash:   ev.FinalizedBlockHash,
 		}
 	}
+	if len(filters[FinalizedLogsSubscription]) > 0 && ev.FinalizedBlockNumber > lastFinalized {
+		es.scheduleFinalizedLogs(lastFinalized, ev.FinalizedBlockNumber)
+	}
+}
+
+// scheduleFinalizedLogs schedules the delivery of the logs of the blocks in
+// range (from, to] which are just finalized, the logs are read by the event
+// loop in batches of finalizedLogsBatch blocks.
+func (es *EventSystem) scheduleFinalizedLogs(from, to uint64) {
+	// Continue with the blocks not delivered yet
+	if es.nextFinalizedLogs != 0 {
+		from = es.nextFinalizedLogs - 1
+	}
+	// The finalized block before the subscriptions are installed is unknown,
+	// only deliver the logs of the latest finalized block
+	if from == 0 {
+		from = to - 1
+	}
+	if to-from > maxFinalizedLogsRange {
+		log.Warn("Too many finalized blocks, skip delivering older logs", "from", from+1, "to", to)
+		from = to - maxFinalizedLogsRange
+	}
+	es.nextFinalizedLogs = from + 1
+}
+
+// handleFinalizedLogs delivers the logs of the next batch of the finalized
+// blocks to the finalized logs subscriptions.
+func (es *EventSystem) handleFinalizedLogs(filters filterIndex) {
+	if len(filters[FinalizedLogsSubscription]) == 0 {
+		es.nextFinalizedLogs = 0
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	for i := 0; i < finalizedLogsBatch && es.nextFinalizedLogs <= es.lastFinalized; i++ {
+		number := es.nextFinalizedLogs
+		es.nextFinalizedLogs++
+
+		hash := rawdb.ReadCanonicalHash(es.backend.ChainDb(), number)
+		if hash == (common.Hash{}) {
+			es.nextFinalizedLogs = 0
+			return
+		}
+		logsList, err := es.backend.GetLogs(ctx, hash)
+		if err != nil {
+			log.Debug("Failed to get logs of finalized block", "number", number, "hash", hash, "err", err)
+			continue
+		}
+		var unfiltered []*types.Log
+		for _, logs := range logsList {
+			unfiltered = append(unfiltered, logs...)
+		}
+		if len(unfiltered) == 0 {
+			continue
+		}
+		for _, f := range filters[FinalizedLogsSubscription] {
+			if matchedLogs := filterLogs(unfiltered, nil, nil, f.logsCrit.Addresses, f.logsCrit.Topics); len(matchedLogs) > 0 {
+				f.logs <- matchedLogs
+			}
+		}
+	}
+	if es.nextFinalizedLogs > es.lastFinalized {
+		es.nextFinalizedLogs = 0
+	}
+}
+
+func (es *EventSystem) handleJustifiedEvent(filters filterIndex, ev core.ChainEvent) {
+	if ev.JustifiedBlockNumber == 0 || es.lastJustified == ev.JustifiedBlockNumber {
+		return
+	}
+	es.lastJustified = ev.JustifiedBlockNumber
+	for _, f := range filters[JustifiedBlockSubscription] {
+		f.justifiers <- &core.JustifiedBlockInfo{
+			JustifiedBlockNumber: hexutil.Uint64(ev.JustifiedBlockNumber),
+			JustifiedBlockHash:   ev.JustifiedBlockHash,
+		}
+	}
+}
+
+func (es *EventSystem) handleInternalTxsEvent(filters filterIndex, ev []*types.InternalTransaction) {
+	for _, f := range filters[InternalTransactionsSubscription] {
+		if matched := filterInternalTxs(ev, f.internalTxsCrit); len(matched) > 0 {
+			f.internalTxs <- matched
+		}
+	}
+}
+
+// filterInternalTxs returns the internal transactions matching the criteria.
+func filterInternalTxs(internalTxs []*types.InternalTransaction, crit InternalTxCriteria) []*types.InternalTransaction {
+	var matched []*types.InternalTransaction
+	for _, internalTx := range internalTxs {
+		if internalTx.InternalTransactionBody == nil {
+			continue
+		}
+		if len(crit.From) > 0 && !includes(crit.From, internalTx.From) {
+			continue
+		}
+		if len(crit.To) > 0 && !includes(crit.To, internalTx.To) {
+			continue
+		}
+		if len(crit.Opcodes) > 0 && !includesOpcode(crit.Opcodes, internalTx.Opcode) {
+			continue
+		}
+		if crit.MinValue != nil && (internalTx.Value == nil || internalTx.Value.Cmp(crit.MinValue.ToInt()) < 0) {
+			continue
+		}
+		matched = append(matched, internalTx)
+	}
+	return matched
+}
+
+func includesOpcode(opcodes []string, opcode string) bool {
+	for _, op := range opcodes {
+		if strings.EqualFold(op, opcode) {
+			return true
+		}
+	}
+	return false
 }
 
 func (es *EventSystem) handleChainEvent(filters filterIndex, ev core.ChainEvent) {
@@ -488,6 +733,7 @@ func (es *EventSystem) eventLoop() {
 		es.rmLogsSub.Unsubscribe()
 		es.pendingLogsSub.Unsubscribe()
 		es.chainSub.Unsubscribe()
+		es.internalTxsSub.Unsubscribe()
 	}()
 
 	index := make(filterIndex)
@@ -496,6 +742,11 @@ func (es *EventSystem) eventLoop() {
 	}
 
 	for {
+		// Read the logs of the pending finalized blocks between the events
+		var finalizedLogs chan struct{}
+		if es.nextFinalizedLogs != 0 {
+			finalizedLogs = closedChan
+		}
 		select {
 		case ev := <-es.txsCh:
 			es.handleTxsEvent(index, ev)
@@ -508,6 +759,11 @@ func (es *EventSystem) eventLoop() {
 		case ev := <-es.chainCh:
 			es.handleChainEvent(index, ev)
 			es.handleFinalizedEvent(index, ev)
+			es.handleJustifiedEvent(index, ev)
+		case ev := <-es.internalTxsCh:
+			es.handleInternalTxsEvent(index, ev)
+		case <-finalizedLogs:
+			es.handleFinalizedLogs(index)
 		case f := <-es.install:
 			if f.typ == MinedAndPendingLogsSubscription {
 				// the type are logs and pending logs subscriptions
@@ -537,6 +793,8 @@ func (es *EventSystem) eventLoop() {
 			return
 		case <-es.chainSub.Err():
 			return
+		case <-es.internalTxsSub.Err():
+			return
 		}
 	}
 }
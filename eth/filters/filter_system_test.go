@@ -18,6 +18,7 @@ package filters
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -36,6 +37,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -53,6 +55,9 @@ type testBackend struct {
 	rmLogsFeed      event.Feed
 	pendingLogsFeed event.Feed
 	chainFeed       event.Feed
+	internalTxFeed  event.Feed
+	finalized       uint64 // Number of the finalized block, none if zero
+	safe            uint64 // Number of the safe block, none if zero
 }
 
 func (b *testBackend) ChainDb() ethdb.Database {
@@ -71,6 +76,15 @@ func (b *testBackend) HeaderByNumber(ctx context.Context, blockNr rpc.BlockNumbe
 			return nil, nil
 		}
 		num = *number
+	} else if blockNr == rpc.FinalizedBlockNumber || blockNr == rpc.SafeBlockNumber {
+		num = b.finalized
+		if blockNr == rpc.SafeBlockNumber {
+			num = b.safe
+		}
+		if num == 0 {
+			return nil, errors.New("header not found")
+		}
+		hash = rawdb.ReadCanonicalHash(b.db, num)
 	} else {
 		num = uint64(blockNr)
 		hash = rawdb.ReadCanonicalHash(b.db, num)
@@ -127,6 +141,10 @@ func (b *testBackend) SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subsc
 	return b.chainFeed.Subscribe(ch)
 }
 
+func (b *testBackend) SubscribeInternalTransactionEvent(ch chan<- []*types.InternalTransaction) event.Subscription {
+	return b.internalTxFeed.Subscribe(ch)
+}
+
 func (b *testBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, b.sections
 }
@@ -213,6 +231,45 @@ func TestFinalizedBlockSubscription(t *testing.T) {
 	<-sub1.Err()
 }
 
+func TestJustifiedBlockSubscription(t *testing.T) {
+	t.Parallel()
+	var (
+		db          = rawdb.NewMemoryDatabase()
+		backend     = &testBackend{db: db}
+		api         = NewPublicFilterAPI(backend, false, deadline)
+		genesis     = (&core.Genesis{BaseFee: big.NewInt(params.InitialBaseFee)}).MustCommit(db)
+		chain, _    = core.GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 10, func(i int, gen *core.BlockGen) {}, true)
+		chainEvents = []core.ChainEvent{}
+	)
+	for i, blk := range chain {
+		chainEvents = append(chainEvents, core.ChainEvent{
+			Hash:                 blk.Hash(),
+			Block:                blk,
+			JustifiedBlockNumber: uint64(i + 1),
+			JustifiedBlockHash:   blk.Hash(),
+		})
+	}
+
+	chan0 := make(chan *core.JustifiedBlockInfo)
+	sub0 := api.events.SubscribeNewJustifiedBlocks(chan0)
+	go func() { // simulate client
+		for i := 0; i != len(chainEvents); i++ {
+			j := <-chan0
+			if hexutil.Uint64(chainEvents[i].JustifiedBlockNumber) != j.JustifiedBlockNumber || chainEvents[i].JustifiedBlockHash != j.JustifiedBlockHash {
+				t.Errorf("sub0 received invalid justified block on index %d, want %d %x, got %d %x",
+					i, chainEvents[i].JustifiedBlockNumber, chainEvents[i].JustifiedBlockHash, j.JustifiedBlockNumber, j.JustifiedBlockHash)
+			}
+		}
+		sub0.Unsubscribe()
+	}()
+
+	time.Sleep(1 * time.Second)
+	for _, e := range chainEvents {
+		backend.chainFeed.Send(e)
+	}
+	<-sub0.Err()
+}
+
 // TestBlockSubscription tests if a block subscription returns block hashes for posted chain events.
 // It creates multiple subscriptions:
 // - one at the start and should receive all posted chain events and a second (blockHashes)
@@ -325,6 +382,60 @@ func TestPendingTxFilter(t *testing.T) {
 	}
 }
 
+// TestInternalTxFilter tests whether the internal transaction filter only
+// returns the internal transactions matching its criteria.
+func TestInternalTxFilter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		backend = &testBackend{db: db}
+		api     = NewPublicFilterAPI(backend, false, deadline)
+
+		contract  = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		recipient = common.HexToAddress("0x2222222222222222222222222222222222222222")
+		other     = common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+		internalTxs = []*types.InternalTransaction{
+			{Opcode: "CALL", Type: "call", Success: true, InternalTransactionBody: &types.InternalTransactionBody{Order: 0, From: contract, To: recipient, Value: big.NewInt(100)}},
+			{Opcode: "CALL", Type: "call", Success: true, InternalTransactionBody: &types.InternalTransactionBody{Order: 1, From: contract, To: recipient, Value: big.NewInt(1)}},
+			{Opcode: "CALL", Type: "call", Success: true, InternalTransactionBody: &types.InternalTransactionBody{Order: 2, From: other, To: recipient, Value: big.NewInt(100)}},
+			{Opcode: "CREATE", Type: "create", Success: true, InternalTransactionBody: &types.InternalTransactionBody{Order: 3, From: contract, To: other, Value: big.NewInt(100)}},
+		}
+
+		results []*ethapi.RPCInternalTransaction
+	)
+
+	fid := api.NewInternalTransactionFilter(InternalTxCriteria{
+		From:     []common.Address{contract},
+		Opcodes:  []string{"call"},
+		MinValue: (*hexutil.Big)(big.NewInt(10)),
+	})
+
+	time.Sleep(1 * time.Second)
+	backend.internalTxFeed.Send(internalTxs)
+
+	timeout := time.Now().Add(1 * time.Second)
+	for {
+		changes, err := api.GetFilterChanges(fid)
+		if err != nil {
+			t.Fatalf("Unable to retrieve internal transactions: %v", err)
+		}
+		results = append(results, changes.([]*ethapi.RPCInternalTransaction)...)
+		if len(results) > 0 || time.Now().After(timeout) {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("invalid number of internal transactions, want 1, got %d", len(results))
+	}
+	if results[0].Order != 0 {
+		t.Errorf("invalid internal transaction, want order 0, got %d", results[0].Order)
+	}
+}
+
 // TestLogFilterCreation test whether a given filter criteria makes sense.
 // If not it must return an error.
 func TestLogFilterCreation(t *testing.T) {
@@ -529,6 +640,69 @@ func TestLogFilter(t *testing.T) {
 	}
 }
 
+// TestFinalizedLogsSubscription tests that the logs subscriptions to the finalized
+// block deliver the logs of the blocks once they are finalized.
+func TestFinalizedLogsSubscription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		backend = &testBackend{db: db}
+		api     = NewPublicFilterAPI(backend, false, deadline)
+		addr    = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		genesis = (&core.Genesis{BaseFee: big.NewInt(params.InitialBaseFee)}).MustCommit(db)
+	)
+	chain, receipts := core.GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 5, func(i int, gen *core.BlockGen) {
+		receipt := types.NewReceipt(nil, false, 0)
+		receipt.Logs = []*types.Log{{Address: addr, Topics: []common.Hash{common.BigToHash(big.NewInt(int64(i + 1)))}}}
+		gen.AddUncheckedReceipt(receipt)
+		gen.AddUncheckedTx(types.NewTransaction(uint64(i), common.HexToAddress("0x1"), big.NewInt(1), 1, gen.BaseFee(), nil))
+	}, true)
+	for i, block := range chain {
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		rawdb.WriteHeadBlockHash(db, block.Hash())
+		rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts[i])
+	}
+
+	logsCh := make(chan []*types.Log)
+	sub, err := api.events.SubscribeLogs(ethereum.FilterQuery{
+		FromBlock: big.NewInt(rpc.FinalizedBlockNumber.Int64()),
+		Addresses: []common.Address{addr},
+	}, logsCh)
+	if err != nil {
+		t.Fatalf("failed to subscribe to finalized logs: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	// The first finalized block only delivers its own logs, the next ones
+	// deliver the logs of all the newly finalized blocks
+	time.Sleep(1 * time.Second)
+	for _, finalized := range []uint64{2, 2, 4} {
+		backend.chainFeed.Send(core.ChainEvent{
+			Hash:                 chain[finalized].Hash(),
+			Block:                chain[finalized],
+			FinalizedBlockNumber: finalized,
+			FinalizedBlockHash:   chain[finalized-1].Hash(),
+		})
+	}
+	for _, want := range []uint64{2, 3, 4} {
+		select {
+		case logs := <-logsCh:
+			if len(logs) != 1 || logs[0].BlockNumber != want || logs[0].Topics[0] != common.BigToHash(new(big.Int).SetUint64(want)) {
+				t.Fatalf("finalized logs mismatch: have %v, want the log of block %d", logs, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("finalized logs of block %d timeout", want)
+		}
+	}
+	select {
+	case logs := <-logsCh:
+		t.Fatalf("unexpected finalized logs: %v", logs)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 // TestPendingLogsSubscription tests if a subscription receives the correct pending logs that are posted to the event feed.
 func TestPendingLogsSubscription(t *testing.T) {
 	t.Parallel()
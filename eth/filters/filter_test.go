@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 func makeReceipt(addr common.Address) *types.Receipt {
@@ -226,4 +227,29 @@ func TestFilters(t *testing.T) {
 	if len(logs) != 0 {
 		t.Error("expected 0 log, got", len(logs))
 	}
+
+	// The finalized and safe tags are resolved to their block numbers
+	filter = NewRangeFilter(backend, 990, int64(rpc.FinalizedBlockNumber), nil, [][]common.Hash{{hash3, hash4}})
+	if _, err := filter.Logs(context.Background()); err == nil {
+		t.Error("expected error without finalized block")
+	}
+
+	backend.finalized, backend.safe = 999, 1000
+	filter = NewRangeFilter(backend, 990, int64(rpc.FinalizedBlockNumber), nil, [][]common.Hash{{hash3, hash4}})
+	logs, _ = filter.Logs(context.Background())
+	if len(logs) != 1 || logs[0].Topics[0] != hash3 {
+		t.Errorf("expected the log of the finalized block, got %v", logs)
+	}
+
+	filter = NewRangeFilter(backend, int64(rpc.SafeBlockNumber), int64(rpc.SafeBlockNumber), nil, [][]common.Hash{{hash3, hash4}})
+	logs, _ = filter.Logs(context.Background())
+	if len(logs) != 1 || logs[0].Topics[0] != hash4 {
+		t.Errorf("expected the log of the safe block, got %v", logs)
+	}
+
+	filter = NewRangeFilter(backend, int64(rpc.FinalizedBlockNumber), -1, nil, [][]common.Hash{{hash3, hash4}})
+	logs, _ = filter.Logs(context.Background())
+	if len(logs) != 2 {
+		t.Error("expected 2 log, got", len(logs))
+	}
 }
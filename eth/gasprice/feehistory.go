@@ -143,6 +143,17 @@ func (oracle *Oracle) resolveBlockRange(ctx context.Context, lastBlock rpc.Block
 		pendingBlock    *types.Block
 		pendingReceipts types.Receipts
 	)
+	// resolve the finalized and safe block to the actual block number
+	if lastBlock == rpc.FinalizedBlockNumber || lastBlock == rpc.SafeBlockNumber {
+		header, err := oracle.backend.HeaderByNumber(ctx, lastBlock)
+		if err != nil {
+			return nil, nil, 0, 0, err
+		}
+		if header == nil {
+			return nil, nil, 0, 0, errors.New("header not found")
+		}
+		lastBlock = rpc.BlockNumber(header.Number.Uint64())
+	}
 	// query either pending block or head header and set headBlock
 	if lastBlock == rpc.PendingBlockNumber {
 		if pendingBlock, pendingReceipts = oracle.backend.PendingBlockAndReceipts(); pendingBlock != nil {
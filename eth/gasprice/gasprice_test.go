@@ -34,7 +34,11 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
-const testHead = 32
+const (
+	testHead      = 32
+	testFinalized = 24 // Number of the finalized block
+	testSafe      = 28 // Number of the safe block
+)
 
 type testBackend struct {
 	chain   *core.BlockChain
@@ -48,6 +52,12 @@ func (b *testBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber
 	if number == rpc.LatestBlockNumber {
 		number = testHead
 	}
+	if number == rpc.FinalizedBlockNumber {
+		number = testFinalized
+	}
+	if number == rpc.SafeBlockNumber {
+		number = testSafe
+	}
 	if number == rpc.PendingBlockNumber {
 		if b.pending {
 			number = testHead + 1
@@ -65,6 +75,12 @@ func (b *testBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber)
 	if number == rpc.LatestBlockNumber {
 		number = testHead
 	}
+	if number == rpc.FinalizedBlockNumber {
+		number = testFinalized
+	}
+	if number == rpc.SafeBlockNumber {
+		number = testSafe
+	}
 	if number == rpc.PendingBlockNumber {
 		if b.pending {
 			number = testHead + 1
@@ -133,6 +133,10 @@ type handler struct {
 	votePool             *vote.VotePool
 	voteCh               chan core.NewVoteEvent
 	voteSub              event.Subscription
+	voteHeadCh           chan core.ChainHeadEvent
+	voteHeadSub          event.Subscription
+	invalidVoteCh        chan core.InvalidVoteEvent
+	invalidVoteSub       event.Subscription
 }
 
 // newHandler returns a handler for all Ethereum chain management protocol.
@@ -493,6 +497,18 @@ func (h *handler) Start(maxPeers int) {
 		h.voteSub = h.votePool.SubscribeNewVoteEvent(h.voteCh)
 		h.wg.Add(1)
 		go h.voteBroadcastLoop()
+
+		// request the missing votes of the new chain heads
+		h.voteHeadCh = make(chan core.ChainHeadEvent, voteHeadChanSize)
+		h.voteHeadSub = h.chain.SubscribeChainHeadEvent(h.voteHeadCh)
+		h.wg.Add(1)
+		go h.voteRequestLoop()
+
+		// penalize the peers sending invalid votes
+		h.invalidVoteCh = make(chan core.InvalidVoteEvent, invalidVoteChanSize)
+		h.invalidVoteSub = h.votePool.SubscribeInvalidVoteEvent(h.invalidVoteCh)
+		h.wg.Add(1)
+		go h.invalidVoteLoop()
 	}
 }
 
@@ -502,6 +518,12 @@ func (h *handler) Stop() {
 	if h.voteSub != nil {
 		h.voteSub.Unsubscribe() // quits voteBroadcastLoop
 	}
+	if h.voteHeadSub != nil {
+		h.voteHeadSub.Unsubscribe() // quits voteRequestLoop
+	}
+	if h.invalidVoteSub != nil {
+		h.invalidVoteSub.Unsubscribe() // quits invalidVoteLoop
+	}
 
 	// Quit chainSync and txsync64.
 	// After this is done, no new peers will be accepted.
@@ -1,11 +1,68 @@
 package eth
 
 import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/consortium/v2/finality"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vote"
 	"github.com/ethereum/go-ethereum/eth/protocols/ronin"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 )
 
+const (
+	// voteHeadChanSize is the size of channel listening to ChainHeadEvent to
+	// request the missing finality votes.
+	voteHeadChanSize = 10
+
+	// voteRequestDelay is the time allowance for the finality votes of a new
+	// chain head to be broadcast before requesting the missing ones.
+	voteRequestDelay = 500 * time.Millisecond
+
+	// voteRequestPeers is the number of `ronin` peers to request the finality
+	// votes of a chain head from.
+	voteRequestPeers = 2
+
+	// invalidVoteChanSize is the size of channel listening to InvalidVoteEvent.
+	invalidVoteChanSize = 10
+
+	// voteScoreHalfLife is the time for the vote penalty score of a peer to
+	// halve, so the occasional invalid votes are forgiven.
+	voteScoreHalfLife = 5 * time.Minute
+
+	// voteThrottleScore is the vote penalty score from which the votes sent by
+	// the peer are dropped without verification.
+	voteThrottleScore = 50
+
+	// voteDisconnectScore is the vote penalty score from which the peer is
+	// disconnected.
+	voteDisconnectScore = 100
+)
+
+var (
+	throttledVoteMeter    = metrics.NewRegisteredMeter("eth/vote/throttled", nil)
+	voteDisconnectedMeter = metrics.NewRegisteredMeter("eth/vote/disconnected", nil)
+)
+
+// votePenalty returns the vote penalty score of a vote verification failure.
+// The invalid signatures are the most penalized as they cost the most to
+// verify and can't be sent by an honest peer.
+func votePenalty(err error) float64 {
+	switch {
+	case errors.Is(err, vote.ErrInvalidVoteSignature):
+		return 40
+	case errors.Is(err, finality.ErrUnauthorizedFinalityVoter):
+		return 20
+	case errors.Is(err, finality.ErrInvalidTargetNumber):
+		return 20
+	default:
+		return 0
+	}
+}
+
 type roninHandler handler
 
 func (r *roninHandler) RunPeer(peer *ronin.Peer, hand ronin.Handler) error {
@@ -26,15 +83,117 @@ func (r *roninHandler) Handle(peer *ronin.Peer, packet ronin.Packet) error {
 	case ronin.NewVoteMsg:
 		if r.votePool != nil {
 			votePacket := packet.(*ronin.NewVotePacket)
-			for _, rawVote := range votePacket.Vote {
-				vote := &types.VoteEnvelope{
-					RawVoteEnvelope: *rawVote,
-				}
-				r.votePool.PutVote(peer.ID(), vote)
-			}
+			r.putVotes(peer, votePacket.Vote)
 		} else {
 			peer.Log().Debug("Local node does not enable fast finality, drop new vote msg")
 		}
+	case ronin.GetVotesByBlockHashMsg:
+		req := packet.(*ronin.GetVotesByBlockHashPacket)
+		var votes []*types.VoteEnvelope
+		if r.votePool != nil {
+			votes = r.votePool.FetchVoteByBlockHash(req.BlockHash)
+		}
+		return peer.ReplyVotes(req.RequestId, votes)
+	case ronin.VotesMsg:
+		if r.votePool != nil {
+			votesPacket := packet.(*ronin.VotesPacket)
+			r.putVotes(peer, votesPacket.Votes)
+		} else {
+			peer.Log().Debug("Local node does not enable fast finality, drop votes msg")
+		}
 	}
 	return nil
 }
+
+// putVotes puts the finality votes received from the peer into the vote pool.
+// The votes of the peers penalized for sending invalid votes are dropped.
+func (r *roninHandler) putVotes(peer *ronin.Peer, rawVotes []*types.RawVoteEnvelope) {
+	if p := r.peers.peer(peer.ID()); p != nil && p.currentVoteScore() >= voteThrottleScore {
+		peer.Log().Trace("Dropping votes from penalized peer", "votes", len(rawVotes))
+		throttledVoteMeter.Mark(int64(len(rawVotes)))
+		return
+	}
+	for _, rawVote := range rawVotes {
+		r.votePool.PutVote(peer.ID(), &types.VoteEnvelope{
+			RawVoteEnvelope: *rawVote,
+		})
+	}
+}
+
+// requestVotes requests the finality votes for the target block from a few
+// `ronin` peers, to fill the votes missed by the vote pool before the next
+// block assembles them. Nothing is requested when the vote pool already holds
+// enough votes to justify the block.
+func (h *handler) requestVotes(target *types.Header) {
+	hash := target.Hash()
+	if h.votePool != nil && h.votePool.HasEnoughVotes(target.Number.Uint64(), hash) {
+		return
+	}
+	peers := h.peers.roninPeersWithVersion(ronin.Ronin2)
+	if len(peers) > voteRequestPeers {
+		peers = peers[:voteRequestPeers]
+	}
+	for _, peer := range peers {
+		if err := peer.RequestVotesByBlockHash(hash); err != nil {
+			peer.Log().Debug("Failed to request finality votes", "hash", hash, "err", err)
+		}
+	}
+}
+
+// voteRequestLoop requests the missing finality votes of the new chain heads
+// once the votes had time to be broadcast.
+func (h *handler) voteRequestLoop() {
+	defer h.wg.Done()
+
+	var (
+		target  *types.Header
+		request <-chan time.Time
+	)
+	for {
+		select {
+		case ev := <-h.voteHeadCh:
+			target = ev.Block.Header()
+			request = time.After(voteRequestDelay)
+		case <-request:
+			request = nil
+			// The votes of the old blocks are useless while syncing
+			if atomic.LoadUint32(&h.acceptTxs) == 1 {
+				h.requestVotes(target)
+			}
+		case <-h.voteHeadSub.Err():
+			return
+		}
+	}
+}
+
+// penalizeVoter adds the penalty of the invalid vote to the score of the peer,
+// the peer is disconnected once its score reaches voteDisconnectScore.
+func (h *handler) penalizeVoter(id string, err error) {
+	peer := h.peers.peer(id)
+	if peer == nil {
+		return
+	}
+	penalty := votePenalty(err)
+	if penalty == 0 {
+		return
+	}
+	if score := peer.penalizeVote(penalty); score >= voteDisconnectScore {
+		peer.Log().Debug("Disconnecting peer sending invalid votes", "score", score, "err", err)
+		voteDisconnectedMeter.Mark(1)
+		h.removePeer(id)
+	}
+}
+
+// invalidVoteLoop penalizes the peers sending the votes proven invalid by the
+// vote pool.
+func (h *handler) invalidVoteLoop() {
+	defer h.wg.Done()
+	for {
+		select {
+		case ev := <-h.invalidVoteCh:
+			h.penalizeVoter(ev.Peer, ev.Err)
+		case <-h.invalidVoteSub.Err():
+			return
+		}
+	}
+}
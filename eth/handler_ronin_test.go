@@ -1,13 +1,18 @@
 package eth
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/consortium/v2/finality"
 	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vote"
+	"github.com/ethereum/go-ethereum/crypto/bls/blst"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/eth/protocols/ronin"
 	"github.com/ethereum/go-ethereum/event"
@@ -19,6 +24,7 @@ import (
 // on the `eth` protocol and convert them into a more easily testable form.
 type testRoninHandler struct {
 	voteBroadcasts event.Feed
+	voteRequests   chan ronin.Packet
 }
 
 func (h *testRoninHandler) RunPeer(*ronin.Peer, ronin.Handler) error { panic("not used in tests") }
@@ -30,6 +36,10 @@ func (h *testRoninHandler) Handle(peer *ronin.Peer, packet ronin.Packet) error {
 		h.voteBroadcasts.Send(packet.Name())
 		return nil
 
+	case ronin.GetVotesByBlockHashMsg, ronin.VotesMsg:
+		h.voteRequests <- packet
+		return nil
+
 	default:
 		panic(fmt.Sprintf("unexpected eth packet type in tests: %T", packet))
 	}
@@ -183,3 +193,237 @@ func TestVoteBroadcast(t *testing.T) {
 		}
 	}
 }
+
+// voteRequestTester is a source handler connected to a ronin/2 sink, which
+// records the votes requests and responses it receives.
+type voteRequestTester struct {
+	source    *testHandler
+	sinkPeer  *ronin.Peer
+	sinkPipe  *p2p.MsgPipeRW
+	requests  chan ronin.Packet
+	protocols []p2p.Protocol
+	caps      []p2p.Cap
+	closers   []func()
+}
+
+func newVoteRequestTester(t *testing.T) *voteRequestTester {
+	tester := &voteRequestTester{
+		protocols: []p2p.Protocol{
+			{
+				Name:    eth.ProtocolName,
+				Version: eth.ETH66,
+			},
+			{
+				Name:    ronin.ProtocolName,
+				Version: ronin.Ronin2,
+			},
+		},
+		caps: []p2p.Cap{
+			{
+				Name:    eth.ProtocolName,
+				Version: eth.ETH66,
+			},
+			{
+				Name:    ronin.ProtocolName,
+				Version: ronin.Ronin2,
+			},
+		},
+		requests: make(chan ronin.Packet, 2),
+	}
+	protocols, caps := tester.protocols, tester.caps
+
+	// Create a source eth handler and connect a sink to it
+	source := newTestHandlerWithBlocks(1)
+	tester.source = source
+
+	var (
+		sinkEth   = new(testEthHandler)
+		sinkRonin = &testRoninHandler{voteRequests: tester.requests}
+		genesis   = source.chain.Genesis()
+		td        = source.chain.GetTd(genesis.Hash(), genesis.NumberU64())
+	)
+	sourceEthPipe, sinkEthPipe := p2p.MsgPipe()
+	sourceEthPeer := eth.NewPeer(eth.ETH66, p2p.NewPeerPipeWithProtocol(enode.ID{1}, "", caps, sourceEthPipe, protocols), sourceEthPipe, nil)
+	sinkEthPeer := eth.NewPeer(eth.ETH66, p2p.NewPeerPipeWithProtocol(enode.ID{0}, "", caps, sinkEthPipe, protocols), sinkEthPipe, nil)
+
+	sourceRoninPipe, sinkRoninPipe := p2p.MsgPipe()
+	sinkRoninPeer := ronin.NewPeer(ronin.Ronin2, p2p.NewPeerPipeWithProtocol(enode.ID{0}, "", caps, sinkRoninPipe, protocols), sinkRoninPipe)
+	tester.sinkPeer, tester.sinkPipe = sinkRoninPeer, sinkRoninPipe
+
+	tester.closers = []func(){
+		sinkRoninPeer.Close,
+		func() { sinkRoninPipe.Close() },
+		func() { sourceRoninPipe.Close() },
+		sinkEthPeer.Close,
+		sourceEthPeer.Close,
+		func() { sinkEthPipe.Close() },
+		func() { sourceEthPipe.Close() },
+		source.close,
+	}
+
+	// The source runs the peer through the negotiated ronin/2 protocol
+	for _, protocol := range ronin.MakeProtocols((*roninHandler)(source.handler)) {
+		if protocol.Version == ronin.Ronin2 {
+			go protocol.Run(p2p.NewPeerPipeWithProtocol(enode.ID{1}, "", caps, sourceRoninPipe, protocols), sourceRoninPipe)
+		}
+	}
+	go ronin.Handle(sinkRonin, sinkRoninPeer)
+	go source.handler.runEthPeer(sourceEthPeer, func(peer *eth.Peer) error {
+		return eth.Handle((*ethHandler)(source.handler), peer)
+	})
+	if err := sinkEthPeer.Handshake(1, td, genesis.Hash(), genesis.Hash(), forkid.NewIDWithChain(source.chain), forkid.NewFilter(source.chain)); err != nil {
+		tester.close()
+		t.Fatalf("failed to run protocol handshake, err %s", err)
+	}
+	go eth.Handle(sinkEth, sinkEthPeer)
+
+	time.Sleep(100 * time.Millisecond)
+	if peers := source.handler.peers.roninPeersWithVersion(ronin.Ronin2); len(peers) != 1 {
+		tester.close()
+		t.Fatalf("ronin/2 peers mismatch: have %d, want 1", len(peers))
+	}
+	return tester
+}
+
+// close tears down the peers and the source handler.
+func (tester *voteRequestTester) close() {
+	for _, close := range tester.closers {
+		close()
+	}
+}
+
+func TestVoteRequest(t *testing.T) {
+	tester := newVoteRequestTester(t)
+	defer tester.close()
+
+	var (
+		source   = tester.source
+		requests = tester.requests
+	)
+	// The source requests the votes from its ronin/2 peers
+	target := source.chain.CurrentBlock().Header()
+	source.handler.requestVotes(target)
+
+	select {
+	case packet := <-requests:
+		req, ok := packet.(*ronin.GetVotesByBlockHashPacket)
+		if !ok || req.BlockHash != target.Hash() {
+			t.Fatalf("unexpected votes request: %v", packet)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("votes request timeout")
+	}
+	// The source serves the votes request of the sink
+	if err := tester.sinkPeer.RequestVotesByBlockHash(target.Hash()); err != nil {
+		t.Fatalf("failed to request votes: %v", err)
+	}
+	select {
+	case packet := <-requests:
+		if res, ok := packet.(*ronin.VotesPacket); !ok || len(res.Votes) != 0 {
+			t.Fatalf("unexpected votes response: %v", packet)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("votes response timeout")
+	}
+	// The ronin/1 peers don't support the votes request
+	legacyPeer := ronin.NewPeer(ronin.Ronin1, p2p.NewPeerPipeWithProtocol(enode.ID{2}, "", tester.caps, tester.sinkPipe, tester.protocols), tester.sinkPipe)
+	defer legacyPeer.Close()
+	if err := legacyPeer.RequestVotesByBlockHash(target.Hash()); err == nil {
+		t.Error("expected error when requesting votes from ronin/1 peer")
+	}
+}
+
+func TestVoteRequestThreshold(t *testing.T) {
+	tester := newVoteRequestTester(t)
+	defer tester.close()
+
+	// The votes are requested until the vote pool holds enough votes to
+	// justify the target, 3 votes out of 3 validators.
+	var (
+		source   = tester.source
+		target   = source.chain.CurrentBlock().Header()
+		votePool = vote.NewVotePool(source.chain, &mockFinalityEngine{validators: 3}, 22, "", 0)
+	)
+	source.handler.votePool = votePool
+	for i := 0; i < 3; i++ {
+		votePool.PutVote("", newTestVote(t, target))
+		for start := time.Now(); len(votePool.FetchVoteByBlockHash(target.Hash())) != i+1; {
+			if time.Since(start) > time.Second {
+				t.Fatalf("vote %d not added to vote pool", i)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		source.handler.requestVotes(target)
+
+		select {
+		case packet := <-tester.requests:
+			if i == 2 {
+				t.Fatalf("unexpected votes request at the threshold: %v", packet)
+			}
+		case <-time.After(200 * time.Millisecond):
+			if i < 2 {
+				t.Fatalf("votes request timeout with %d votes", i+1)
+			}
+		}
+	}
+}
+
+// mockFinalityEngine is a fast finality engine with a fixed number of
+// validators, accepting all the votes.
+type mockFinalityEngine struct {
+	consensus.FastFinalityPoSA
+	validators int
+}
+
+func (e *mockFinalityEngine) GetJustifiedBlock(consensus.ChainHeaderReader, uint64, common.Hash) (uint64, common.Hash) {
+	return 0, common.Hash{}
+}
+
+func (e *mockFinalityEngine) VerifyVote(consensus.ChainHeaderReader, *types.VoteEnvelope) error {
+	return nil
+}
+
+func (e *mockFinalityEngine) GetActiveValidatorAt(consensus.ChainHeaderReader, uint64, common.Hash) []finality.ValidatorWithBlsPub {
+	return make([]finality.ValidatorWithBlsPub, e.validators)
+}
+
+// newTestVote returns a finality vote for the target block signed by a new
+// random key.
+func newTestVote(t *testing.T, target *types.Header) *types.VoteEnvelope {
+	secretKey, err := blst.RandKey()
+	if err != nil {
+		t.Fatalf("failed to generate secret key: %v", err)
+	}
+	data := &types.VoteData{
+		TargetNumber: target.Number.Uint64(),
+		TargetHash:   target.Hash(),
+	}
+	envelope := &types.VoteEnvelope{RawVoteEnvelope: types.RawVoteEnvelope{Data: data}}
+	copy(envelope.PublicKey[:], secretKey.PublicKey().Marshal())
+	copy(envelope.Signature[:], secretKey.Sign(data.Hash().Bytes()).Marshal())
+	return envelope
+}
+
+func TestVotePenaltyScore(t *testing.T) {
+	peer := new(ethPeer)
+	if penalty := votePenalty(errors.New("header not found")); penalty != 0 {
+		t.Fatalf("unexpected penalty for unprovable failure: %v", penalty)
+	}
+	// An occasional invalid vote doesn't throttle the peer
+	if score := peer.penalizeVote(votePenalty(finality.ErrInvalidTargetNumber)); score >= voteThrottleScore {
+		t.Fatalf("peer throttled after one invalid vote: score %v", score)
+	}
+	// The repeat offenders are throttled then disconnected
+	if score := peer.penalizeVote(votePenalty(fmt.Errorf("%w: bad", vote.ErrInvalidVoteSignature))); score < voteThrottleScore || score >= voteDisconnectScore {
+		t.Fatalf("vote score mismatch: have %v, want throttled", score)
+	}
+	peer.penalizeVote(votePenalty(vote.ErrInvalidVoteSignature))
+	if score := peer.penalizeVote(votePenalty(vote.ErrInvalidVoteSignature)); score < voteDisconnectScore {
+		t.Fatalf("vote score mismatch: have %v, want disconnected", score)
+	}
+	// The score halves every half-life
+	peer.voteScore, peer.voteScoreAt = 80, time.Now().Add(-voteScoreHalfLife)
+	if score := peer.currentVoteScore(); score < 39 || score > 40 {
+		t.Fatalf("decayed vote score mismatch: have %v, want 40", score)
+	}
+}
@@ -17,6 +17,7 @@
 package eth
 
 import (
+	"math"
 	"math/big"
 	"sync"
 	"time"
@@ -42,7 +43,42 @@ type ethPeer struct {
 
 	syncDrop *time.Timer   // Connection dropper if `eth` sync progress isn't validated in time
 	snapWait chan struct{} // Notification channel for snap connections
-	lock     sync.RWMutex  // Mutex protecting the internal fields
+
+	voteScore   float64   // Penalty score for the invalid finality votes sent by the peer
+	voteScoreAt time.Time // Time of the last update of the vote penalty score
+
+	lock sync.RWMutex // Mutex protecting the internal fields
+}
+
+// penalizeVote adds the penalty of an invalid finality vote to the vote score
+// of the peer and returns the new score.
+func (p *ethPeer) penalizeVote(penalty float64) float64 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	p.voteScore = p.decayedVoteScore(now) + penalty
+	p.voteScoreAt = now
+	return p.voteScore
+}
+
+// currentVoteScore returns the vote penalty score of the peer.
+func (p *ethPeer) currentVoteScore() float64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.decayedVoteScore(time.Now())
+}
+
+// decayedVoteScore returns the vote penalty score at the given time, the score
+// halves every voteScoreHalfLife so only the repeat offenders are punished.
+// The caller must hold the peer lock.
+func (p *ethPeer) decayedVoteScore(now time.Time) float64 {
+	if p.voteScore == 0 {
+		return 0
+	}
+	elapsed := now.Sub(p.voteScoreAt)
+	return p.voteScore * math.Pow(0.5, float64(elapsed)/float64(voteScoreHalfLife))
 }
 
 // info gathers and returns some `eth` protocol metadata known about a peer.
@@ -331,6 +331,21 @@ func (ps *peerSet) roninPeerWithoutVote(hash common.Hash) []*ronin.Peer {
 	return roninPeers
 }
 
+// roninPeersWithVersion retrieves a list of `ronin` peers running the given
+// protocol version or newer.
+func (ps *peerSet) roninPeersWithVersion(version uint) []*ronin.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	var roninPeers []*ronin.Peer
+	for _, peer := range ps.peers {
+		if peer.roninExt != nil && peer.roninExt.Version() >= version {
+			roninPeers = append(roninPeers, peer.roninExt)
+		}
+	}
+	return roninPeers
+}
+
 // close disconnects all peers.
 func (ps *peerSet) close() {
 	ps.lock.Lock()
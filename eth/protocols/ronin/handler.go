@@ -33,6 +33,8 @@ type Backend interface {
 func MakeProtocols(backend Backend) []p2p.Protocol {
 	protocol := make([]p2p.Protocol, len(ProtocolVersions))
 	for i, version := range ProtocolVersions {
+		version := version // Closure
+
 		protocol[i] = p2p.Protocol{
 			Name:    ProtocolName,
 			Version: version,
@@ -112,6 +114,48 @@ func handleMessage(backend Backend, peer *Peer) error {
 		}
 
 		return backend.Handle(peer, &votePacket)
+	case GetVotesByBlockHashMsg:
+		if peer.Version() < Ronin2 {
+			return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
+		}
+		var req GetVotesByBlockHashPacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		return backend.Handle(peer, &req)
+	case VotesMsg:
+		if peer.Version() < Ronin2 {
+			return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
+		}
+		var res VotesPacket
+		if err := msg.Decode(&res); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if len(res.Votes) > maxVotesServe {
+			return fmt.Errorf("%w: %d > %d", errTooManyVotes, len(res.Votes), maxVotesServe)
+		}
+		requestTracker.Fulfil(peer.id, peer.version, VotesMsg, res.RequestId)
+
+		// Only the votes for the block requested from the peer are accepted
+		hash, ok := peer.fulfilVoteRequest(res.RequestId)
+		if !ok {
+			peer.Log().Debug("Dropping unsolicited votes", "id", res.RequestId, "votes", len(res.Votes))
+			return nil
+		}
+		votes := res.Votes[:0]
+		for _, packet := range res.Votes {
+			if packet.Data == nil || packet.Data.TargetHash != hash {
+				continue
+			}
+			vote := types.VoteEnvelope{
+				RawVoteEnvelope: *packet,
+			}
+			peer.markFinalityVote(vote.Hash())
+			votes = append(votes, packet)
+		}
+		res.Votes = votes
+
+		return backend.Handle(peer, &res)
 	default:
 		return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
 	}
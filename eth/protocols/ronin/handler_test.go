@@ -0,0 +1,136 @@
+package ronin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// testBackend is a mock `ronin` backend handing over the running peers and the
+// packets passed to the backend.
+type testBackend struct {
+	peers   chan *Peer
+	packets chan Packet
+}
+
+func newTestBackend() *testBackend {
+	return &testBackend{
+		peers:   make(chan *Peer, 1),
+		packets: make(chan Packet, 1),
+	}
+}
+
+func (b *testBackend) RunPeer(peer *Peer, handler Handler) error {
+	b.peers <- peer
+	return handler(peer)
+}
+
+func (b *testBackend) PeerInfo(enode.ID) interface{} { return nil }
+
+func (b *testBackend) Handle(peer *Peer, packet Packet) error {
+	b.packets <- packet
+	return nil
+}
+
+// runTestPeer runs a peer through the protocol of the given version, it returns
+// the running peer and the remote end of its connection.
+func runTestPeer(t *testing.T, backend *testBackend, version uint) (*Peer, *p2p.MsgPipeRW) {
+	var protocol *p2p.Protocol
+	for _, proto := range MakeProtocols(backend) {
+		if proto.Version == version {
+			proto := proto
+			protocol = &proto
+		}
+	}
+	if protocol == nil {
+		t.Fatalf("protocol %s/%d not found", ProtocolName, version)
+	}
+	local, remote := p2p.MsgPipe()
+	t.Cleanup(func() {
+		local.Close()
+		remote.Close()
+	})
+	go protocol.Run(p2p.NewPeer(enode.ID{1}, "", nil), local)
+
+	select {
+	case peer := <-backend.peers:
+		return peer, remote
+	case <-time.After(time.Second):
+		t.Fatal("peer not running")
+	}
+	return nil, nil
+}
+
+func TestProtocolVersions(t *testing.T) {
+	for _, version := range ProtocolVersions {
+		peer, _ := runTestPeer(t, newTestBackend(), version)
+		if peer.Version() != version {
+			t.Errorf("peer version mismatch: have %d, want %d", peer.Version(), version)
+		}
+	}
+}
+
+func TestVotesResponse(t *testing.T) {
+	var (
+		backend      = newTestBackend()
+		peer, remote = runTestPeer(t, backend, Ronin2)
+		target       = common.Hash{0x01}
+	)
+	vote := func(hash common.Hash) *types.RawVoteEnvelope {
+		return &types.RawVoteEnvelope{Data: &types.VoteData{TargetNumber: 1, TargetHash: hash}}
+	}
+	// The unsolicited votes are dropped
+	if err := p2p.Send(remote, VotesMsg, &VotesPacket{RequestId: 1, Votes: []*types.RawVoteEnvelope{vote(target)}}); err != nil {
+		t.Fatalf("failed to send votes: %v", err)
+	}
+	select {
+	case packet := <-backend.packets:
+		t.Fatalf("unsolicited votes delivered: %v", packet)
+	case <-time.After(100 * time.Millisecond):
+	}
+	// The requested votes are delivered, only those for the requested block
+	errc := make(chan error, 1)
+	go func() { errc <- peer.RequestVotesByBlockHash(target) }()
+	select {
+	case err := <-errc:
+		t.Fatalf("failed to request votes: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	msg, err := remote.ReadMsg()
+	if err != nil {
+		t.Fatalf("failed to read votes request: %v", err)
+	}
+	var req GetVotesByBlockHashPacket
+	if err := msg.Decode(&req); err != nil {
+		t.Fatalf("failed to decode votes request: %v", err)
+	}
+	if req.BlockHash != target {
+		t.Fatalf("requested block mismatch: have %x, want %x", req.BlockHash, target)
+	}
+	votes := []*types.RawVoteEnvelope{vote(target), vote(common.Hash{0x02})}
+	if err := p2p.Send(remote, VotesMsg, &VotesPacket{RequestId: req.RequestId, Votes: votes}); err != nil {
+		t.Fatalf("failed to send votes: %v", err)
+	}
+	select {
+	case packet := <-backend.packets:
+		res, ok := packet.(*VotesPacket)
+		if !ok || len(res.Votes) != 1 || res.Votes[0].Data.TargetHash != target {
+			t.Fatalf("unexpected votes delivered: %v", packet)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("votes not delivered")
+	}
+	// The request is answered only once
+	if err := p2p.Send(remote, VotesMsg, &VotesPacket{RequestId: req.RequestId, Votes: votes}); err != nil {
+		t.Fatalf("failed to send votes: %v", err)
+	}
+	select {
+	case packet := <-backend.packets:
+		t.Fatalf("duplicate votes response delivered: %v", packet)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -1,6 +1,9 @@
 package ronin
 
 import (
+	"fmt"
+	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,8 +17,21 @@ const (
 	voteChannelSize = 50
 	batchInterval   = 100 * time.Millisecond
 	maxKnownVote    = 8192
+
+	// maxVoteRequests is the maximum number of votes requests pending on a peer.
+	maxVoteRequests = 16
+
+	// voteRequestTimeout is the time after which a pending votes request is
+	// dropped, its late response is then discarded.
+	voteRequestTimeout = 10 * time.Second
 )
 
+// voteRequest is a votes request sent to the peer waiting for its response.
+type voteRequest struct {
+	hash common.Hash // Hash of the block whose votes are requested
+	time time.Time   // Timestamp when the request was sent
+}
+
 // Peer is a collection of relevant information we have about a `ronin` peer.
 type Peer struct {
 	id string // Unique ID for the peer, cached
@@ -29,6 +45,9 @@ type Peer struct {
 	logger log.Logger // Contextual logger with the peer id injected
 
 	knownFinalityVote *protocols.KnownCache // Set of finality vote hashes knowed to be known by this peer
+
+	voteRequests map[uint64]*voteRequest // Votes requests waiting for their response
+	voteReqLock  sync.Mutex              // Lock protecting the pending votes requests
 }
 
 // NewPeer create a wrapper for a network connection and negotiated  protocol
@@ -44,6 +63,7 @@ func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
 		term:              make(chan struct{}),
 		logger:            log.New("peer", id[:8]),
 		knownFinalityVote: protocols.NewKnownCache(maxKnownVote),
+		voteRequests:      make(map[uint64]*voteRequest),
 	}
 	go peer.batchVote()
 
@@ -115,6 +135,77 @@ func (p *Peer) batchVote() {
 	}
 }
 
+// RequestVotesByBlockHash fetches the finality votes for the target block
+// from the remote peer, the peer must run ronin/2 or newer.
+func (p *Peer) RequestVotesByBlockHash(hash common.Hash) error {
+	if p.version < Ronin2 {
+		return fmt.Errorf("votes request not supported by %s/%d", ProtocolName, p.version)
+	}
+	id := rand.Uint64()
+	if err := p.trackVoteRequest(id, hash); err != nil {
+		return err
+	}
+	p.Log().Debug("Fetching finality votes", "hash", hash)
+
+	requestTracker.Track(p.id, p.version, GetVotesByBlockHashMsg, VotesMsg, id)
+	return p2p.Send(p.rw, GetVotesByBlockHashMsg, &GetVotesByBlockHashPacket{
+		RequestId: id,
+		BlockHash: hash,
+	})
+}
+
+// trackVoteRequest records a votes request sent to the peer, the expired
+// requests are dropped.
+func (p *Peer) trackVoteRequest(id uint64, hash common.Hash) error {
+	p.voteReqLock.Lock()
+	defer p.voteReqLock.Unlock()
+
+	for reqID, req := range p.voteRequests {
+		if time.Since(req.time) > voteRequestTimeout {
+			delete(p.voteRequests, reqID)
+		}
+	}
+	if len(p.voteRequests) >= maxVoteRequests {
+		return fmt.Errorf("too many pending votes requests: %d", len(p.voteRequests))
+	}
+	p.voteRequests[id] = &voteRequest{hash: hash, time: time.Now()}
+	return nil
+}
+
+// fulfilVoteRequest marks the votes request as answered, it returns the hash of
+// the requested block, or false if there is no such pending request.
+func (p *Peer) fulfilVoteRequest(id uint64) (common.Hash, bool) {
+	p.voteReqLock.Lock()
+	defer p.voteReqLock.Unlock()
+
+	req, ok := p.voteRequests[id]
+	if !ok {
+		return common.Hash{}, false
+	}
+	delete(p.voteRequests, id)
+	if time.Since(req.time) > voteRequestTimeout {
+		return common.Hash{}, false
+	}
+	return req.hash, true
+}
+
+// ReplyVotes is the response to GetVotesByBlockHash, the votes are marked as
+// known by the peer.
+func (p *Peer) ReplyVotes(id uint64, votes []*types.VoteEnvelope) error {
+	if len(votes) > maxVotesServe {
+		votes = votes[:maxVotesServe]
+	}
+	rawVotes := make([]*types.RawVoteEnvelope, 0, len(votes))
+	for _, vote := range votes {
+		rawVotes = append(rawVotes, vote.Raw())
+		p.markFinalityVote(vote.Hash())
+	}
+	return p2p.Send(p.rw, VotesMsg, &VotesPacket{
+		RequestId: id,
+		Votes:     rawVotes,
+	})
+}
+
 // KnownFinalityVote returns whether peer is known to already have a vote.
 func (p *Peer) KnownFinalityVote(hash common.Hash) bool {
 	return p.knownFinalityVote.Contains(hash)
@@ -0,0 +1,10 @@
+package ronin
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/tracker"
+)
+
+// requestTracker is a singleton tracker for ronin/2 and newer request times.
+var requestTracker = tracker.New(ProtocolName, 5*time.Minute)
@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -70,6 +71,11 @@ const (
 	// for tracing. The creation of trace state will be paused if the unused
 	// trace states exceed this limit.
 	maximumPendingTraceStates = 128
+
+	// defaultTraceChainCheckpointInterval is the maximum number of blocks traced
+	// by a resumed traceChain without streaming any result. A checkpoint marker
+	// is sent once the limit is reached, so the clients can resume from it.
+	defaultTraceChainCheckpointInterval = uint64(128)
 )
 
 var errTxNotFound = errors.New("transaction not found")
@@ -165,6 +171,25 @@ type TraceConfig struct {
 	// Config specific to given tracer. Note struct logger
 	// config are historically embedded in main object.
 	TracerConfig json.RawMessage
+	// ExcludeSystemTxs skips the system transactions of the consensus engine
+	// when tracing a block, they are still executed to keep the state.
+	ExcludeSystemTxs bool
+}
+
+// TraceChainConfig is the config for traceChain API. It holds the options to
+// resume an interrupted chain tracing and the interval of the checkpoints.
+type TraceChainConfig struct {
+	TraceConfig
+	// StartHash overrides the start block, it must be a canonical block.
+	StartHash *common.Hash
+	// ResumeToken is the checkpoint of an interrupted chain tracing, the tracing
+	// continues from the block following the checkpoint.
+	ResumeToken *string
+	// CheckpointInterval is the maximum number of blocks traced without
+	// streaming any result, a checkpoint marker is streamed once it's reached.
+	// The markers are only streamed if either the interval or the resume token
+	// is set, the interval defaults to defaultTraceChainCheckpointInterval then.
+	CheckpointInterval *uint64
 }
 
 // TraceCallConfig is the config for traceCall API. It holds one more
@@ -212,9 +237,10 @@ type blockTraceTask struct {
 // blockTraceResult represets the results of tracing a single block when an entire
 // chain is being traced.
 type blockTraceResult struct {
-	Block  hexutil.Uint64   `json:"block"`  // Block number corresponding to this trace
-	Hash   common.Hash      `json:"hash"`   // Block hash corresponding to this trace
-	Traces []*txTraceResult `json:"traces"` // Trace results produced by the task
+	Block      hexutil.Uint64   `json:"block"`      // Block number corresponding to this trace
+	Hash       common.Hash      `json:"hash"`       // Block hash corresponding to this trace
+	Traces     []*txTraceResult `json:"traces"`     // Trace results produced by the task
+	Checkpoint string           `json:"checkpoint"` // Token to resume the chain tracing after this block
 }
 
 // txTraceTask represents a single transaction trace task when an entire block
@@ -226,18 +252,16 @@ type txTraceTask struct {
 
 // TraceChain returns the structured logs created during the execution of EVM
 // between two blocks (excluding start) and returns them as a JSON object.
-func (api *API) TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *TraceConfig) (*rpc.Subscription, error) { // Fetch the block interval that we want to trace
-	from, err := api.blockByNumber(ctx, start)
-	if err != nil {
-		return nil, err
-	}
-	to, err := api.blockByNumber(ctx, end)
+//
+// Every streamed block carries a checkpoint token, which can be passed as the
+// resume token of a new chain tracing to continue after the block if the
+// subscription is interrupted.
+func (api *API) TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *TraceChainConfig) (*rpc.Subscription, error) {
+	// Fetch the block interval that we want to trace
+	from, to, err := api.traceChainRange(ctx, start, end, config)
 	if err != nil {
 		return nil, err
 	}
-	if from.Number().Cmp(to.Number()) >= 0 {
-		return nil, fmt.Errorf("end block (#%d) needs to come after start block (#%d)", end, start)
-	}
 	// Tracing a chain is a **long** operation, only do with subscriptions
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
@@ -254,11 +278,103 @@ func (api *API) TraceChain(ctx context.Context, start, end rpc.BlockNumber, conf
 	return sub, nil
 }
 
+// traceChainRange returns the start and the end blocks of the chain tracing,
+// the start block is overridden by the resume token or the start hash of the
+// config if any.
+func (api *API) traceChainRange(ctx context.Context, start, end rpc.BlockNumber, config *TraceChainConfig) (*types.Block, *types.Block, error) {
+	var (
+		from *types.Block
+		err  error
+	)
+	switch {
+	case config != nil && config.ResumeToken != nil:
+		number, hash, err := decodeTraceChainCheckpoint(*config.ResumeToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		if from, err = api.canonicalBlock(ctx, number, hash); err != nil {
+			return nil, nil, fmt.Errorf("invalid resume token: %w", err)
+		}
+	case config != nil && config.StartHash != nil:
+		header, err := api.backend.HeaderByHash(ctx, *config.StartHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if header == nil {
+			return nil, nil, fmt.Errorf("block %s not found", config.StartHash.Hex())
+		}
+		if from, err = api.canonicalBlock(ctx, header.Number.Uint64(), *config.StartHash); err != nil {
+			return nil, nil, fmt.Errorf("invalid start hash: %w", err)
+		}
+	default:
+		if from, err = api.blockByNumber(ctx, start); err != nil {
+			return nil, nil, err
+		}
+	}
+	to, err := api.blockByNumber(ctx, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	if from.Number().Cmp(to.Number()) >= 0 {
+		return nil, nil, fmt.Errorf("end block (#%d) needs to come after start block (#%d)", to.NumberU64(), from.NumberU64())
+	}
+	return from, to, nil
+}
+
+// canonicalBlock returns the block of the given number and hash, it fails if
+// the block is not in the canonical chain, as the chain tracing follows the
+// canonical blocks.
+func (api *API) canonicalBlock(ctx context.Context, number uint64, hash common.Hash) (*types.Block, error) {
+	block, err := api.blockByNumber(ctx, rpc.BlockNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	if block.Hash() != hash {
+		return nil, fmt.Errorf("block #%d %s is not canonical", number, hash.Hex())
+	}
+	return block, nil
+}
+
+// encodeTraceChainCheckpoint returns the token to resume the chain tracing
+// after the given block.
+func encodeTraceChainCheckpoint(number uint64, hash common.Hash) string {
+	token := make([]byte, 8+common.HashLength)
+	binary.BigEndian.PutUint64(token, number)
+	copy(token[8:], hash.Bytes())
+	return hexutil.Encode(token)
+}
+
+// decodeTraceChainCheckpoint returns the number and the hash of the block of
+// the chain tracing checkpoint token.
+func decodeTraceChainCheckpoint(token string) (uint64, common.Hash, error) {
+	data, err := hexutil.Decode(token)
+	if err != nil || len(data) != 8+common.HashLength {
+		return 0, common.Hash{}, fmt.Errorf("invalid resume token %q", token)
+	}
+	return binary.BigEndian.Uint64(data), common.BytesToHash(data[8:]), nil
+}
+
 // traceChain configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
-func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed <-chan interface{}) chan *blockTraceResult {
-	reexec := defaultTraceReexec
+//
+// The blocks are traced concurrently but streamed in order. The blocks without
+// any transaction are skipped, except the end block and, if the checkpoints are
+// requested, a checkpoint marker every CheckpointInterval blocks.
+func (api *API) traceChain(start, end *types.Block, chainConfig *TraceChainConfig, closed <-chan interface{}) chan *blockTraceResult {
+	var (
+		config   *TraceConfig
+		reexec   = defaultTraceReexec
+		interval uint64 // No checkpoint markers unless requested
+	)
+	if chainConfig != nil {
+		config = &chainConfig.TraceConfig
+		if chainConfig.CheckpointInterval != nil {
+			interval = *chainConfig.CheckpointInterval
+		} else if chainConfig.ResumeToken != nil {
+			interval = defaultTraceChainCheckpointInterval
+		}
+	}
 	if config != nil && config.Reexec != nil {
 		reexec = *config.Reexec
 	}
@@ -417,8 +533,9 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed
 	go func() {
 		defer close(retCh)
 		var (
-			next = start.NumberU64() + 1
-			done = make(map[uint64]*blockTraceResult)
+			next     = start.NumberU64() + 1
+			done     = make(map[uint64]*blockTraceResult)
+			streamed = start.NumberU64() // Last block streamed or the start one
 		)
 		for res := range resCh {
 			// Queue up next received result
@@ -431,7 +548,10 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed
 
 			// Stream completed traces to the result channel
 			for result, ok := done[next]; ok; result, ok = done[next] {
-				if len(result.Traces) > 0 || next == end.NumberU64() {
+				if len(result.Traces) > 0 || next == end.NumberU64() || (interval > 0 && next-streamed >= interval) {
+					result.Checkpoint = encodeTraceChainCheckpoint(next, result.Hash)
+					streamed = next
+
 					// It will be blocked in case the channel consumer doesn't take the
 					// tracing result in time(e.g. the websocket connect is not stable)
 					// which will eventually block the entire chain tracer. It's the
@@ -601,6 +721,10 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 	if block.NumberU64() == 0 {
 		return nil, errors.New("genesis is not traceable")
 	}
+	// Serve the results from the trace cache if the block was traced at import
+	if results := api.cachedBlockTraces(block, config); results != nil {
+		return results, nil
+	}
 	// Prepare base state
 	parent, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(block.NumberU64()-1), block.ParentHash())
 	if err != nil {
@@ -631,11 +755,22 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		is158     = api.backend.ChainConfig().IsEIP158(block.Number())
 		blockCtx  = core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
 		signer    = types.MakeSigner(api.backend.ChainConfig(), block.Number())
-		results   = make([]*txTraceResult, len(txs))
+		results   = make([]*txTraceResult, 0, len(txs))
 	)
 	for i, tx := range txs {
 		// Generate the next state snapshot fast without tracing
 		msg, _ := tx.AsMessage(signer, block.BaseFee())
+		if config != nil && config.ExcludeSystemTxs && consortium.IsSystemMessage(api.backend.Engine(), msg, block) {
+			statedb.Prepare(tx.Hash(), i)
+			vmenv := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, api.backend.ChainConfig(), vm.Config{})
+			consortium.HandleSystemTransaction(api.backend.Engine(), statedb, msg, block)
+			vmenv.Config.IsSystemTransaction = true
+			if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
+				return nil, err
+			}
+			statedb.Finalise(is158)
+			continue
+		}
 		txctx := &Context{
 			BlockHash:   blockHash,
 			BlockNumber: block.Number(),
@@ -646,7 +781,7 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		if err != nil {
 			return nil, err
 		}
-		results[i] = &txTraceResult{TransactionHash: tx.Hash(), Result: res}
+		results = append(results, &txTraceResult{TransactionHash: tx.Hash(), Result: res})
 		// Finalize the state so any modifications are written to the trie
 		// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
 		statedb.Finalise(is158)
@@ -697,19 +832,27 @@ func (api *API) traceBlockParallel(ctx context.Context, block *types.Block, stat
 
 	// Feed the transactions into the tracers and return
 	var failed error
+	var excluded []bool
+	if config != nil && config.ExcludeSystemTxs {
+		excluded = make([]bool, len(txs))
+	}
 txloop:
 	for i, tx := range txs {
-		// Send the trace task over for execution
-		task := &txTraceTask{statedb: statedb.Copy(), index: i}
-		select {
-		case <-ctx.Done():
-			failed = ctx.Err()
-			break txloop
-		case jobs <- task:
+		msg, _ := tx.AsMessage(signer, block.BaseFee())
+		if excluded != nil && consortium.IsSystemMessage(api.backend.Engine(), msg, block) {
+			excluded[i] = true
+		} else {
+			// Send the trace task over for execution
+			task := &txTraceTask{statedb: statedb.Copy(), index: i}
+			select {
+			case <-ctx.Done():
+				failed = ctx.Err()
+				break txloop
+			case jobs <- task:
+			}
 		}
 
 		// Generate the next state snapshot fast without tracing
-		msg, _ := tx.AsMessage(signer, block.BaseFee())
 		statedb.Prepare(tx.Hash(), i)
 		vmenv := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, api.backend.ChainConfig(), vm.Config{})
 		if consortium.HandleSystemTransaction(api.backend.Engine(), statedb, msg, block) {
@@ -731,6 +874,15 @@ txloop:
 	if failed != nil {
 		return nil, failed
 	}
+	if excluded != nil {
+		traced := results[:0]
+		for i, result := range results {
+			if !excluded[i] {
+				traced = append(traced, result)
+			}
+		}
+		results = traced
+	}
 	return results, nil
 }
 
@@ -984,6 +1136,9 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 	if blockNumber == 0 {
 		return nil, errors.New("genesis is not traceable")
 	}
+	if result := api.cachedTxTrace(blockNumber, blockHash, index, config); result != nil {
+		return result, nil
+	}
 	reexec := defaultTraceReexec
 	if config != nil && config.Reexec != nil {
 		reexec = *config.Reexec
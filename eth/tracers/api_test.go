@@ -43,6 +43,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -144,6 +145,10 @@ func (b *testBackend) ChainDb() ethdb.Database {
 	return b.chaindb
 }
 
+func (b *testBackend) SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription {
+	return b.chain.SubscribeChainEvent(ch)
+}
+
 // teardown releases the associated resources.
 func (b *testBackend) teardown() {
 	b.chain.Stop()
@@ -1199,7 +1204,7 @@ func TestTraceChain(t *testing.T) {
 	var cases = []struct {
 		start  uint64
 		end    uint64
-		config *TraceConfig
+		config *TraceChainConfig
 	}{
 		{0, 50, nil},  // the entire chain range, blocks [1, 50]
 		{10, 20, nil}, // the middle chain range, blocks [11, 20]
@@ -1237,3 +1242,76 @@ func TestTraceChain(t *testing.T) {
 		}
 	}
 }
+
+func TestTraceChainCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: core.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	signer := types.HomesteadSigner{}
+	backend := newTestBackend(t, 20, genesis, func(i int, b *core.BlockGen) {
+		// Only the first block has a transaction
+		if i == 0 {
+			tx, _ := types.SignTx(types.NewTransaction(0, accounts[1].addr, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, accounts[0].key)
+			b.AddTx(tx)
+		}
+	})
+	defer backend.teardown()
+	api := NewAPI(backend)
+
+	interval := uint64(5)
+	config := &TraceChainConfig{CheckpointInterval: &interval}
+	from, to, err := api.traceChainRange(context.Background(), 0, 20, config)
+	if err != nil {
+		t.Fatalf("failed to get chain range: %v", err)
+	}
+	// The empty blocks are skipped except the checkpoint markers and the end
+	var (
+		streamed []uint64
+		tokens   = make(map[uint64]string)
+	)
+	for result := range api.traceChain(from, to, config, nil) {
+		streamed = append(streamed, uint64(result.Block))
+		tokens[uint64(result.Block)] = result.Checkpoint
+	}
+	if want := []uint64{1, 6, 11, 16, 20}; !reflect.DeepEqual(streamed, want) {
+		t.Fatalf("streamed blocks mismatch: have %v, want %v", streamed, want)
+	}
+	// No checkpoint marker is streamed unless requested
+	streamed = nil
+	for result := range api.traceChain(from, to, &TraceChainConfig{}, nil) {
+		streamed = append(streamed, uint64(result.Block))
+	}
+	if want := []uint64{1, 20}; !reflect.DeepEqual(streamed, want) {
+		t.Fatalf("streamed blocks mismatch: have %v, want %v", streamed, want)
+	}
+	// The tracing resumes after the checkpoint
+	token := tokens[11]
+	config.ResumeToken = &token
+	if from, _, err = api.traceChainRange(context.Background(), 0, 20, config); err != nil {
+		t.Fatalf("failed to resume from checkpoint: %v", err)
+	}
+	if from.NumberU64() != 11 {
+		t.Errorf("resumed start mismatch: have %d, want 11", from.NumberU64())
+	}
+	// The start hash overrides the start block
+	startHash := backend.chain.GetBlockByNumber(5).Hash()
+	if from, _, err = api.traceChainRange(context.Background(), 0, 20, &TraceChainConfig{StartHash: &startHash}); err != nil {
+		t.Fatalf("failed to start from hash: %v", err)
+	}
+	if from.NumberU64() != 5 {
+		t.Errorf("start mismatch: have %d, want 5", from.NumberU64())
+	}
+	// The non-canonical and malformed tokens are rejected
+	for _, token := range []string{encodeTraceChainCheckpoint(11, common.Hash{0x01}), "0x1234"} {
+		token := token
+		if _, _, err := api.traceChainRange(context.Background(), 0, 20, &TraceChainConfig{ResumeToken: &token}); err == nil {
+			t.Errorf("expected error for resume token %s", token)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package tracers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// chainEventChanSize is the size of channel listening to ChainEvent.
+const chainEventChanSize = 10
+
+var (
+	traceCacheHitMeter  = metrics.NewRegisteredMeter("tracers/cache/hit", nil)
+	traceCacheMissMeter = metrics.NewRegisteredMeter("tracers/cache/miss", nil)
+	traceCacheTimer     = metrics.NewRegisteredTimer("tracers/cache/trace", nil)
+)
+
+// CacheBackend is the backend of the trace cache, it's notified of the newly
+// imported blocks.
+type CacheBackend interface {
+	Backend
+	SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription
+}
+
+// TraceCache traces the newly imported blocks with a configured set of tracers
+// and persists the results, so that the debug tracing APIs can serve them
+// without re-executing the blocks. Only the results of the last blocks are
+// kept in the database, the older ones are pruned as the chain progresses.
+//
+// The results are stored in the key-value store rather than in a freezer
+// table, as the freezer can only be truncated from its head and the results
+// out of the cached range couldn't be pruned.
+type TraceCache struct {
+	api     *API
+	backend CacheBackend
+	tracers []string // Names of the tracers run on the imported blocks
+	blocks  uint64   // Number of recent blocks whose trace results are kept
+	pruned  uint64   // Number of the first block whose trace results may be stored
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewTraceCache creates a trace cache which runs the given tracers on the
+// imported blocks and keeps the results of the last blocks.
+func NewTraceCache(backend CacheBackend, tracers []string, blocks uint64) (*TraceCache, error) {
+	if blocks == 0 {
+		return nil, errors.New("no block to cache the trace results of")
+	}
+	// Only the named tracers are cached, not the arbitrary JS code
+	for _, name := range tracers {
+		if _, ok := DefaultDirectory.elems[name]; !ok {
+			return nil, fmt.Errorf("unknown tracer %q", name)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TraceCache{
+		api:     NewAPI(backend),
+		backend: backend,
+		tracers: tracers,
+		blocks:  blocks,
+		ctx:     ctx,
+		cancel:  cancel,
+	}, nil
+}
+
+// Start implements node.Lifecycle, starting the background tracing of the
+// imported blocks.
+func (c *TraceCache) Start() error {
+	c.wg.Add(1)
+	go c.loop()
+	log.Info("Started trace cache", "tracers", c.tracers, "blocks", c.blocks)
+	return nil
+}
+
+// Stop implements node.Lifecycle, terminating the background tracing.
+func (c *TraceCache) Stop() error {
+	c.cancel()
+	c.wg.Wait()
+	log.Info("Stopped trace cache")
+	return nil
+}
+
+// loop receives the imported blocks and traces them one by one. The chain
+// events are queued while a block is being traced so that the import is never
+// blocked by the tracing, only the last blocks of the queue are kept.
+func (c *TraceCache) loop() {
+	defer c.wg.Done()
+
+	chainCh := make(chan core.ChainEvent, chainEventChanSize)
+	sub := c.backend.SubscribeChainEvent(chainCh)
+	defer sub.Unsubscribe()
+
+	var (
+		queue []*types.Block
+		done  chan struct{}
+	)
+	for {
+		if done == nil && len(queue) > 0 {
+			block := queue[0]
+			queue = queue[1:]
+
+			done = make(chan struct{})
+			go func() {
+				defer close(done)
+				c.cacheBlock(c.ctx, block)
+			}()
+		}
+		select {
+		case ev := <-chainCh:
+			queue = append(queue, ev.Block)
+			if uint64(len(queue)) > c.blocks {
+				queue = queue[uint64(len(queue))-c.blocks:]
+			}
+		case <-done:
+			done = nil
+		case <-sub.Err():
+			if done != nil {
+				<-done
+			}
+			return
+		case <-c.ctx.Done():
+			if done != nil {
+				<-done
+			}
+			return
+		}
+	}
+}
+
+// cacheBlock runs the configured tracers on the block, stores the results and
+// prunes the results of the blocks out of the cached range.
+func (c *TraceCache) cacheBlock(ctx context.Context, block *types.Block) {
+	number := block.NumberU64()
+	if number == 0 {
+		return
+	}
+	db := c.backend.ChainDb()
+	for _, name := range c.tracers {
+		if ctx.Err() != nil {
+			return
+		}
+		name := name
+		start := time.Now()
+		results, err := c.api.traceBlock(ctx, block, &TraceConfig{Tracer: &name})
+		if err != nil {
+			log.Debug("Failed to cache block traces", "number", number, "hash", block.Hash(), "tracer", name, "err", err)
+			continue
+		}
+		traceCacheTimer.UpdateSince(start)
+
+		encoded := make([][]byte, len(results))
+		for i, result := range results {
+			// The failed traces are not cached, they are re-executed when requested
+			if result.Error != "" {
+				continue
+			}
+			if encoded[i], err = json.Marshal(result.Result); err != nil {
+				log.Debug("Failed to encode transaction trace", "hash", result.TransactionHash, "tracer", name, "err", err)
+			}
+		}
+		rawdb.WriteTraceResults(db, number, block.Hash(), name, encoded)
+	}
+	// The blocks are cached one at a time, so the pruned mark needs no lock
+	if limit := number + 1; limit > c.blocks && limit-c.blocks > c.pruned {
+		limit -= c.blocks
+		if deleted := rawdb.DeleteTraceResultsRange(db, c.pruned, limit); deleted > 0 {
+			log.Debug("Pruned cached block traces", "from", c.pruned, "limit", limit, "deleted", deleted)
+		}
+		c.pruned = limit
+	}
+}
+
+// cachedTracer returns the name of the tracer whose results may be served from
+// the trace cache for the given config. Only the tracers run with the default
+// config are cached.
+func cachedTracer(config *TraceConfig) (string, bool) {
+	if config == nil || config.Tracer == nil || *config.Tracer == "" || config.FullCallTracing {
+		return "", false
+	}
+	if cfg := bytes.TrimSpace(config.TracerConfig); len(cfg) > 0 && !bytes.Equal(cfg, []byte("null")) && !bytes.Equal(cfg, []byte("{}")) {
+		return "", false
+	}
+	return *config.Tracer, true
+}
+
+// cachedBlockTraces returns the cached trace results of the block transactions
+// for the given config, or nil if they are not all cached.
+func (api *API) cachedBlockTraces(block *types.Block, config *TraceConfig) []*txTraceResult {
+	name, ok := cachedTracer(config)
+	if !ok || config.ExcludeSystemTxs {
+		return nil
+	}
+	txs := block.Transactions()
+	cached := rawdb.ReadTraceResults(api.backend.ChainDb(), block.NumberU64(), block.Hash(), name)
+	if len(cached) != len(txs) || len(txs) == 0 {
+		traceCacheMissMeter.Mark(1)
+		return nil
+	}
+	results := make([]*txTraceResult, len(txs))
+	for i, tx := range txs {
+		if len(cached[i]) == 0 {
+			traceCacheMissMeter.Mark(1)
+			return nil
+		}
+		results[i] = &txTraceResult{TransactionHash: tx.Hash(), Result: json.RawMessage(cached[i])}
+	}
+	traceCacheHitMeter.Mark(1)
+	return results
+}
+
+// cachedTxTrace returns the cached trace result of the transaction at the
+// given index of the block for the given config, or nil if it's not cached.
+func (api *API) cachedTxTrace(number uint64, hash common.Hash, index uint64, config *TraceConfig) json.RawMessage {
+	name, ok := cachedTracer(config)
+	if !ok {
+		return nil
+	}
+	cached := rawdb.ReadTraceResults(api.backend.ChainDb(), number, hash, name)
+	if index >= uint64(len(cached)) || len(cached[index]) == 0 {
+		traceCacheMissMeter.Mark(1)
+		return nil
+	}
+	traceCacheHitMeter.Mark(1)
+	return cached[index]
+}
@@ -0,0 +1,127 @@
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// cacheTestTraces counts the transactions traced by the cacheTestTracer.
+var cacheTestTraces int32
+
+func init() {
+	DefaultDirectory.Register("cacheTestTracer", func(ctx *Context, _ json.RawMessage) (Tracer, error) {
+		return &cacheTestTracer{txHash: ctx.TxHash}, nil
+	}, false)
+}
+
+// cacheTestTracer is a tracer whose result is the traced transaction hash along
+// with the number of the transactions traced so far.
+type cacheTestTracer struct {
+	txHash common.Hash
+}
+
+func (t *cacheTestTracer) CaptureTxStart(gasLimit uint64) {}
+func (t *cacheTestTracer) CaptureTxEnd(restGas uint64)    {}
+func (t *cacheTestTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+}
+func (t *cacheTestTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {}
+func (t *cacheTestTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+func (t *cacheTestTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+}
+func (t *cacheTestTracer) CaptureExit(output []byte, gasUsed uint64, err error) {}
+func (t *cacheTestTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+func (t *cacheTestTracer) Stop(err error) {}
+
+func (t *cacheTestTracer) GetResult() (json.RawMessage, error) {
+	traced := atomic.AddInt32(&cacheTestTraces, 1)
+	return json.RawMessage(fmt.Sprintf(`{"tx":"%s","traced":%d}`, t.txHash.Hex(), traced)), nil
+}
+
+func TestTraceCache(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: core.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	var (
+		signer = types.HomesteadSigner{}
+		txs    []common.Hash
+	)
+	backend := newTestBackend(t, 4, genesis, func(i int, b *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(uint64(i), accounts[1].addr, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, accounts[0].key)
+		b.AddTx(tx)
+		txs = append(txs, tx.Hash())
+	})
+	defer backend.teardown()
+
+	if _, err := NewTraceCache(backend, []string{"unknownTracer"}, 2); err == nil {
+		t.Fatal("expected error for unknown tracer")
+	}
+	cache, err := NewTraceCache(backend, []string{"cacheTestTracer"}, 2)
+	if err != nil {
+		t.Fatalf("failed to create trace cache: %v", err)
+	}
+	base := atomic.LoadInt32(&cacheTestTraces)
+	for number := uint64(1); number <= 4; number++ {
+		cache.cacheBlock(context.Background(), backend.chain.GetBlockByNumber(number))
+	}
+	// Only the results of the last 2 blocks are kept
+	for number := uint64(1); number <= 4; number++ {
+		block := backend.chain.GetBlockByNumber(number)
+		results := rawdb.ReadTraceResults(backend.chaindb, number, block.Hash(), "cacheTestTracer")
+		if cached := len(results) != 0; cached != (number > 2) {
+			t.Errorf("block %d: cached mismatch: have %v, want %v", number, cached, number > 2)
+		}
+	}
+	var (
+		api    = NewAPI(backend)
+		tracer = "cacheTestTracer"
+		traced = atomic.LoadInt32(&cacheTestTraces)
+	)
+	// The cached transaction trace is served without re-execution
+	result, err := api.TraceTransaction(context.Background(), txs[3], &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		t.Fatalf("failed to trace transaction: %v", err)
+	}
+	if want := fmt.Sprintf(`{"tx":"%s","traced":%d}`, txs[3].Hex(), base+4); string(result.(json.RawMessage)) != want {
+		t.Errorf("cached result mismatch: have %s, want %s", result, want)
+	}
+	results, err := api.TraceBlockByNumber(context.Background(), rpc.BlockNumber(3), &TraceConfig{Tracer: &tracer})
+	if err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	if want := fmt.Sprintf(`{"tx":"%s","traced":%d}`, txs[2].Hex(), base+3); len(results) != 1 || string(results[0].Result.(json.RawMessage)) != want {
+		t.Errorf("cached block result mismatch: have %v, want %s", results, want)
+	}
+	if have := atomic.LoadInt32(&cacheTestTraces); have != traced {
+		t.Errorf("cached traces re-executed: have %d traces, want %d", have, traced)
+	}
+	// The pruned and the differently configured traces are re-executed
+	if _, err := api.TraceTransaction(context.Background(), txs[0], &TraceConfig{Tracer: &tracer}); err != nil {
+		t.Fatalf("failed to trace transaction: %v", err)
+	}
+	if _, err := api.TraceTransaction(context.Background(), txs[3], &TraceConfig{Tracer: &tracer, TracerConfig: json.RawMessage(`{"onlyTopCall":true}`)}); err != nil {
+		t.Fatalf("failed to trace transaction: %v", err)
+	}
+	if have := atomic.LoadInt32(&cacheTestTraces); have != traced+2 {
+		t.Errorf("uncached traces mismatch: have %d traces, want %d", have, traced+2)
+	}
+}
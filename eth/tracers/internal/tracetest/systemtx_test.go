@@ -0,0 +1,117 @@
+package tracetest
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	roninValidatorSet "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/ronin_validator_set"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/tests"
+)
+
+type systemTxTrace struct {
+	From           common.Address `json:"from"`
+	To             common.Address `json:"to"`
+	Contract       string         `json:"contract"`
+	Method         string         `json:"method"`
+	Purpose        string         `json:"purpose"`
+	Value          *hexutil.Big   `json:"value"`
+	Error          string         `json:"error"`
+	BalanceChanges map[common.Address]struct {
+		Before *hexutil.Big `json:"before"`
+		After  *hexutil.Big `json:"after"`
+	} `json:"balanceChanges"`
+}
+
+// Tests that the system tracer labels the system transactions and collects the
+// balance changes they made, while other transactions are ignored.
+func TestSystemTxTracer(t *testing.T) {
+	var (
+		coinbase  = common.HexToAddress("0x00000000000000000000000000000000000c0ffe")
+		validator = common.HexToAddress("0x0000000000000000000000000000000000000a00")
+		recipient = common.HexToAddress("0x0000000000000000000000000000000000000b00")
+		config    = *params.TestChainConfig
+	)
+	config.ConsortiumV2Contracts = &params.ConsortiumV2Contracts{RoninValidatorSet: validator}
+
+	// The validator set contract forwards the received value to the recipient
+	code := []byte{
+		byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), // in and outs zero
+		byte(vm.CALLVALUE), byte(vm.PUSH20),
+	}
+	code = append(code, recipient.Bytes()...)
+	code = append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.STOP))
+
+	parsed, err := roninValidatorSet.RoninValidatorSetMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("failed to parse abi: %v", err)
+	}
+	input, err := parsed.Pack("submitBlockReward")
+	if err != nil {
+		t.Fatalf("failed to pack input: %v", err)
+	}
+	for _, system := range []bool{true, false} {
+		_, statedb := tests.MakePreState(rawdb.NewMemoryDatabase(), core.GenesisAlloc{
+			coinbase:  {Balance: big.NewInt(1000)},
+			validator: {Code: code, Balance: big.NewInt(0)},
+		}, false)
+		tracer, err := tracers.DefaultDirectory.New("systemTxTracer", new(tracers.Context), nil)
+		if err != nil {
+			t.Fatalf("failed to create system tx tracer: %v", err)
+		}
+		context := vm.BlockContext{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			Coinbase:    coinbase,
+			BlockNumber: big.NewInt(1),
+			Time:        5,
+			Difficulty:  big.NewInt(0x30000),
+			GasLimit:    uint64(6000000),
+			BaseFee:     big.NewInt(0),
+		}
+		evm := vm.NewEVM(context, vm.TxContext{Origin: coinbase, GasPrice: big.NewInt(0)}, statedb, &config, vm.Config{Debug: true, Tracer: tracer, IsSystemTransaction: system})
+		msg := types.NewMessage(coinbase, &validator, 0, big.NewInt(100), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false)
+		if _, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
+			t.Fatalf("failed to execute transaction: %v", err)
+		}
+		res, err := tracer.GetResult()
+		if err != nil {
+			t.Fatalf("failed to retrieve trace result: %v", err)
+		}
+		if !system {
+			if string(res) != "null" {
+				t.Errorf("unexpected result of non-system transaction: %s", res)
+			}
+			continue
+		}
+		have := new(systemTxTrace)
+		if err := json.Unmarshal(res, have); err != nil {
+			t.Fatalf("failed to unmarshal trace result: %v", err)
+		}
+		if have.Contract != "RoninValidatorSet" || have.Method != "submitBlockReward" || have.Purpose == "" {
+			t.Errorf("system call label mismatch: contract %q, method %q, purpose %q", have.Contract, have.Method, have.Purpose)
+		}
+		if have.Error != "" {
+			t.Errorf("unexpected error: %s", have.Error)
+		}
+		// The validator set contract only forwards the value, so its balance
+		// is not changed
+		if len(have.BalanceChanges) != 2 {
+			t.Fatalf("balance changes mismatch: have %d, want 2", len(have.BalanceChanges))
+		}
+		for addr, want := range map[common.Address][2]int64{coinbase: {1000, 900}, recipient: {0, 100}} {
+			change := have.BalanceChanges[addr]
+			if change.Before.ToInt().Int64() != want[0] || change.After.ToInt().Int64() != want[1] {
+				t.Errorf("balance change of %x mismatch: have %v -> %v, want %v", addr, change.Before, change.After, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	finalityTracking "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/finality_tracking"
+	"github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/profile"
+	roninValidatorSet "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/ronin_validator_set"
+	slashIndicator "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/slash_indicator"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func init() {
+	tracers.DefaultDirectory.Register("systemTxTracer", newSystemTxTracer, false)
+}
+
+// systemCallPurposes describes the consensus purpose of the system calls made
+// by the Consortium engine, keyed by the called method.
+var systemCallPurposes = map[string]string{
+	"wrapUpEpoch":         "Wrap up the epoch: distribute the period rewards and update the validator set",
+	"submitBlockReward":   "Submit the block reward and the transaction fees collected by the block producer",
+	"slashUnavailability": "Slash the validator who missed its turn to produce the block",
+	"recordFinality":      "Record the validators who voted for the finality of the block",
+}
+
+var (
+	systemContractABIsOnce sync.Once
+	systemContractABIs     map[string]*abi.ABI
+)
+
+// loadSystemContractABIs parses the ABIs of the system contracts, keyed by the
+// contract names.
+func loadSystemContractABIs() map[string]*abi.ABI {
+	systemContractABIsOnce.Do(func() {
+		systemContractABIs = make(map[string]*abi.ABI)
+		for name, metadata := range map[string]interface {
+			GetAbi() (*abi.ABI, error)
+		}{
+			"RoninValidatorSet": roninValidatorSet.RoninValidatorSetMetaData,
+			"SlashIndicator":    slashIndicator.SlashIndicatorMetaData,
+			"Profile":           profile.ProfileMetaData,
+			"FinalityTracking":  finalityTracking.FinalityTrackingMetaData,
+		} {
+			parsed, err := metadata.GetAbi()
+			if err != nil {
+				log.Error("Failed to parse system contract ABI", "contract", name, "err", err)
+				continue
+			}
+			systemContractABIs[name] = parsed
+		}
+	})
+	return systemContractABIs
+}
+
+// balanceChange is the balance of an account before and after the system
+// transaction.
+type balanceChange struct {
+	Before *hexutil.Big `json:"before"`
+	After  *hexutil.Big `json:"after"`
+}
+
+// systemTxResult is the labeled system call along with the balance changes it
+// made.
+type systemTxResult struct {
+	From           common.Address                    `json:"from"`
+	To             common.Address                    `json:"to"`
+	Contract       string                            `json:"contract,omitempty"`
+	Method         string                            `json:"method,omitempty"`
+	Purpose        string                            `json:"purpose,omitempty"`
+	Args           map[string]interface{}            `json:"args,omitempty"`
+	Value          *hexutil.Big                      `json:"value"`
+	GasUsed        hexutil.Uint64                    `json:"gasUsed"`
+	Error          string                            `json:"error,omitempty"`
+	BalanceChanges map[common.Address]*balanceChange `json:"balanceChanges"`
+}
+
+// systemTxTracer is a native go tracer which labels the system transactions
+// made by the Consortium engine (wrapUpEpoch, submitBlockReward, slash, ...)
+// with their consensus purpose, decodes their arguments with the system
+// contract ABIs and collects the balance changes they made. The result of
+// any other transaction is null.
+//
+// Example:
+//
+//	> debug.traceBlockByNumber("0x1c2", {tracer: "systemTxTracer"})
+type systemTxTracer struct {
+	noopTracer
+	env       *vm.EVM
+	result    *systemTxResult
+	deltas    []map[common.Address]*big.Int // Balance deltas of the active call frames
+	interrupt atomic.Bool                   // Atomic flag to signal execution interruption
+	reason    error                         // Textual reason for the interruption
+}
+
+// newSystemTxTracer returns a native go tracer which labels system transactions,
+// and implements vm.EVMLogger.
+func newSystemTxTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &systemTxTracer{}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *systemTxTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	if !env.Config.IsSystemTransaction {
+		return
+	}
+	t.env = env
+	t.result = &systemTxResult{
+		From:  from,
+		To:    to,
+		Value: (*hexutil.Big)(new(big.Int).Set(value)),
+	}
+	t.label(to, input)
+	t.deltas = []map[common.Address]*big.Int{make(map[common.Address]*big.Int)}
+	t.transfer(from, to, value)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *systemTxTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	if t.result == nil {
+		return
+	}
+	t.result.GasUsed = hexutil.Uint64(gasUsed)
+	t.result.BalanceChanges = make(map[common.Address]*balanceChange)
+	if err != nil {
+		// All the balance changes are reverted along with the call
+		t.result.Error = err.Error()
+		return
+	}
+	for addr, delta := range t.deltas[0] {
+		if delta.Sign() == 0 {
+			continue
+		}
+		after := t.env.StateDB.GetBalance(addr)
+		t.result.BalanceChanges[addr] = &balanceChange{
+			Before: (*hexutil.Big)(new(big.Int).Sub(after, delta)),
+			After:  (*hexutil.Big)(new(big.Int).Set(after)),
+		}
+	}
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *systemTxTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.result == nil || t.interrupt.Load() {
+		return
+	}
+	t.deltas = append(t.deltas, make(map[common.Address]*big.Int))
+	// Only these operations transfer the value between the accounts
+	if typ == vm.CALL || typ == vm.CREATE || typ == vm.CREATE2 || typ == vm.SELFDESTRUCT {
+		t.transfer(from, to, value)
+	}
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *systemTxTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.result == nil || t.interrupt.Load() || len(t.deltas) < 2 {
+		return
+	}
+	frame := t.deltas[len(t.deltas)-1]
+	t.deltas = t.deltas[:len(t.deltas)-1]
+	// The balance changes of a failed scope are reverted
+	if err != nil {
+		return
+	}
+	parent := t.deltas[len(t.deltas)-1]
+	for addr, delta := range frame {
+		if parent[addr] == nil {
+			parent[addr] = new(big.Int)
+		}
+		parent[addr].Add(parent[addr], delta)
+	}
+}
+
+// GetResult returns the json-encoded labeled system call, and any error arising
+// from the encoding or forceful termination (via `Stop`).
+func (t *systemTxTracer) GetResult() (json.RawMessage, error) {
+	if t.result == nil {
+		return json.RawMessage("null"), t.reason
+	}
+	res, err := json.Marshal(t.result)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *systemTxTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// transfer records a value transfer in the current call frame.
+func (t *systemTxTracer) transfer(from, to common.Address, value *big.Int) {
+	if value == nil || value.Sign() == 0 || from == to {
+		return
+	}
+	frame := t.deltas[len(t.deltas)-1]
+	for addr, amount := range map[common.Address]*big.Int{from: new(big.Int).Neg(value), to: value} {
+		if frame[addr] == nil {
+			frame[addr] = new(big.Int)
+		}
+		frame[addr].Add(frame[addr], amount)
+	}
+}
+
+// label names the called system contract and method, and decodes the call
+// arguments.
+func (t *systemTxTracer) label(to common.Address, input []byte) {
+	contracts := t.env.ChainConfig().ConsortiumV2Contracts
+	if contracts == nil {
+		return
+	}
+	switch to {
+	case contracts.RoninValidatorSet:
+		t.result.Contract = "RoninValidatorSet"
+	case contracts.SlashIndicator:
+		t.result.Contract = "SlashIndicator"
+	case contracts.ProfileContract:
+		t.result.Contract = "Profile"
+	case contracts.FinalityTracking:
+		t.result.Contract = "FinalityTracking"
+	case contracts.StakingContract:
+		t.result.Contract = "Staking"
+	}
+	parsed := loadSystemContractABIs()[t.result.Contract]
+	if parsed == nil || len(input) < 4 {
+		return
+	}
+	method, err := parsed.MethodById(input[:4])
+	if err != nil {
+		return
+	}
+	t.result.Method = method.RawName
+	t.result.Purpose = systemCallPurposes[method.RawName]
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, input[4:]); err != nil {
+		log.Debug("Failed to decode system call arguments", "method", method.RawName, "err", err)
+		return
+	}
+	if len(args) > 0 {
+		t.result.Args = args
+	}
+}
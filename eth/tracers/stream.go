@@ -0,0 +1,74 @@
+package tracers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// traceChainRequest is the body of the chain tracing streaming request, it has
+// the same parameters as the debug_traceChain subscription.
+type traceChainRequest struct {
+	Start  rpc.BlockNumber   `json:"start"`
+	End    rpc.BlockNumber   `json:"end"`
+	Config *TraceChainConfig `json:"config"`
+}
+
+// traceChainHandler streams the chain tracing results over HTTP as newline
+// delimited JSON, one traced block per line, for the clients which can't keep
+// a websocket connection open during a long chain tracing.
+type traceChainHandler struct {
+	api *API
+}
+
+// NewTraceChainHandler returns a HTTP handler which streams the chain tracing
+// results as newline delimited JSON.
+func NewTraceChainHandler(backend Backend) http.Handler {
+	return &traceChainHandler{api: NewAPI(backend)}
+}
+
+// ServeHTTP implements http.Handler, tracing the requested chain segment and
+// streaming the results until the tracing ends or the client disconnects.
+func (h *traceChainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	var req traceChainRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	from, to, err := h.api.traceChainRange(r.Context(), req.Start, req.End, req.Config)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Tracing a chain outlasts the write timeout of the HTTP server
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Debug("Failed to clear chain tracing write deadline", "err", err)
+	}
+	closed := make(chan interface{})
+	go func() {
+		<-r.Context().Done()
+		close(closed)
+	}()
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	var (
+		resCh = h.api.traceChain(from, to, req.Config, closed)
+		enc   = json.NewEncoder(w)
+	)
+	for result := range resCh {
+		if err := enc.Encode(result); err != nil {
+			log.Debug("Failed to stream chain tracing result", "block", result.Block, "err", err)
+			continue
+		}
+		rc.Flush()
+	}
+}
@@ -0,0 +1,69 @@
+package tracers
+
+import (
+	"bufio"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestTraceChainHandler(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: core.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	signer := types.HomesteadSigner{}
+	backend := newTestBackend(t, 10, genesis, func(i int, b *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(uint64(i), accounts[1].addr, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, accounts[0].key)
+		b.AddTx(tx)
+	})
+	defer backend.teardown()
+	handler := NewTraceChainHandler(backend)
+
+	// The invalid requests are rejected before streaming
+	for _, body := range []string{`{"start":"0x5","end":"0x2"}`, `{"start":"0x0","end":"0xa","config":{"resumeToken":"0x12"}}`, `not json`} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/traceChain", strings.NewReader(body)))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("request %s: status mismatch: have %d, want %d", body, rec.Code, http.StatusBadRequest)
+		}
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/traceChain", strings.NewReader(`{"start":"0x2","end":"0xa"}`)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status mismatch: have %d, want %d", rec.Code, http.StatusOK)
+	}
+	if ctype := rec.Header().Get("Content-Type"); ctype != "application/x-ndjson" {
+		t.Errorf("content type mismatch: have %s", ctype)
+	}
+	// One line per traced block, in order
+	var (
+		scanner = bufio.NewScanner(rec.Body)
+		next    = uint64(3)
+	)
+	for scanner.Scan() {
+		var result blockTraceResult
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			t.Fatalf("failed to decode line %q: %v", scanner.Text(), err)
+		}
+		if uint64(result.Block) != next || len(result.Traces) != 1 || result.Checkpoint == "" {
+			t.Errorf("block %d: unexpected result %s", next, scanner.Text())
+		}
+		next++
+	}
+	if next != 11 {
+		t.Errorf("streamed blocks mismatch: have %d, want 8", next-3)
+	}
+}
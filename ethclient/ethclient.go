@@ -530,6 +530,72 @@ func (ec *Client) SendTransaction(ctx context.Context, tx *types.Transaction) er
 	return ec.c.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(data))
 }
 
+// signTransactionResult is the result of eth_signTransactionAsPayer and
+// eth_signSponsoredTransaction.
+type signTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// SignTransactionAsPayer requests the node to sign the sponsored transaction
+// sent by sender with the payer account, the payer account must be unlocked
+// in the node. The missing gas and fee fields are filled by the node.
+// The returned transaction carries the payer signature only and must be
+// signed by the sender afterwards.
+func (ec *Client) SignTransactionAsPayer(ctx context.Context, payer, sender common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	var res signTransactionResult
+	if err := ec.c.CallContext(ctx, &res, "eth_signTransactionAsPayer", payer, toSponsoredTxArg(sender, tx)); err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(res.Raw); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// SignSponsoredTransaction requests the node to sign the sponsored transaction,
+// which is already signed by the payer, with the sender account. The sender
+// account must be unlocked in the node, which refuses to sign if the payer
+// signature is not from the given payer.
+func (ec *Client) SignSponsoredTransaction(ctx context.Context, payer, sender common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	var res signTransactionResult
+	if err := ec.c.CallContext(ctx, &res, "eth_signSponsoredTransaction", payer, toSponsoredTxArg(sender, tx)); err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(res.Raw); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+func toSponsoredTxArg(sender common.Address, tx *types.Transaction) interface{} {
+	arg := map[string]interface{}{
+		"from":        sender,
+		"to":          tx.To(),
+		"value":       (*hexutil.Big)(tx.Value()),
+		"input":       hexutil.Bytes(tx.Data()),
+		"nonce":       hexutil.Uint64(tx.Nonce()),
+		"expiredTime": hexutil.Uint64(tx.ExpiredTime()),
+	}
+	if tx.Gas() != 0 {
+		arg["gas"] = hexutil.Uint64(tx.Gas())
+	}
+	if tx.GasFeeCap() != nil && tx.GasFeeCap().Sign() != 0 {
+		arg["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	}
+	if chainID := tx.ChainId(); chainID != nil && chainID.Sign() != 0 {
+		arg["chainId"] = (*hexutil.Big)(chainID)
+	}
+	if v, r, s := tx.RawPayerSignatureValues(); r != nil && r.Sign() != 0 {
+		arg["payerV"] = (*hexutil.Big)(v)
+		arg["payerR"] = (*hexutil.Big)(r)
+		arg["payerS"] = (*hexutil.Big)(s)
+	}
+	return arg
+}
+
 func toBlockNumArg(number *big.Int) string {
 	if number == nil || number.Cmp(big.NewInt(int64(rpc.LatestBlockNumber))) == 0 {
 		return "latest"
@@ -540,6 +606,9 @@ func toBlockNumArg(number *big.Int) string {
 	if number.Cmp(big.NewInt(int64(rpc.FinalizedBlockNumber))) == 0 {
 		return "finalized"
 	}
+	if number.Cmp(big.NewInt(int64(rpc.SafeBlockNumber))) == 0 {
+		return "safe"
+	}
 	return hexutil.EncodeBig(number)
 }
 
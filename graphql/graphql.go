@@ -41,6 +41,18 @@ var (
 
 type Long int64
 
+// invalidLong is the value of the negative numbers, which are never valid block
+// numbers, so that they are not mistaken for the block tags.
+const invalidLong = Long(math.MinInt64)
+
+// newLong returns the Long of a number, the negative ones are invalid.
+func newLong(value int64) Long {
+	if value < 0 {
+		return invalidLong
+	}
+	return Long(value)
+}
+
 // ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
 func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
 
@@ -49,6 +61,18 @@ func (b *Long) UnmarshalGraphQL(input interface{}) error {
 	var err error
 	switch input := input.(type) {
 	case string:
+		// Block tags are accepted wherever a block number is expected
+		switch input {
+		case "latest":
+			*b = Long(rpc.LatestBlockNumber)
+			return nil
+		case "finalized":
+			*b = Long(rpc.FinalizedBlockNumber)
+			return nil
+		case "safe":
+			*b = Long(rpc.SafeBlockNumber)
+			return nil
+		}
 		// uncomment to support hex values
 		//if strings.HasPrefix(input, "0x") {
 		//	// apply leniency and support hex representations of longs.
@@ -57,13 +81,13 @@ func (b *Long) UnmarshalGraphQL(input interface{}) error {
 		//	return err
 		//} else {
 		value, err := strconv.ParseInt(input, 10, 64)
-		*b = Long(value)
+		*b = newLong(value)
 		return err
 		//}
 	case int32:
-		*b = Long(input)
+		*b = newLong(int64(input))
 	case int64:
-		*b = Long(input)
+		*b = newLong(input)
 	default:
 		err = fmt.Errorf("unexpected type %T for Long", input)
 	}
@@ -447,6 +471,59 @@ func (t *Transaction) AccessList(ctx context.Context) (*[]*AccessTuple, error) {
 	return &ret, nil
 }
 
+func (t *Transaction) Payer(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type() != types.SponsoredTxType {
+		return nil, err
+	}
+	signer := types.LatestSigner(t.backend.ChainConfig())
+	payer, err := types.Payer(signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		backend:       t.backend,
+		address:       payer,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) ExpiredTime(ctx context.Context) (*Long, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type() != types.SponsoredTxType {
+		return nil, err
+	}
+	ret := Long(tx.ExpiredTime())
+	return &ret, nil
+}
+
+func (t *Transaction) PayerR(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type() != types.SponsoredTxType {
+		return nil, err
+	}
+	_, r, _ := tx.RawPayerSignatureValues()
+	return (*hexutil.Big)(r), nil
+}
+
+func (t *Transaction) PayerS(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type() != types.SponsoredTxType {
+		return nil, err
+	}
+	_, _, s := tx.RawPayerSignatureValues()
+	return (*hexutil.Big)(s), nil
+}
+
+func (t *Transaction) PayerV(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type() != types.SponsoredTxType {
+		return nil, err
+	}
+	v, _, _ := tx.RawPayerSignatureValues()
+	return (*hexutil.Big)(v), nil
+}
+
 func (t *Transaction) R(ctx context.Context) (hexutil.Big, error) {
 	tx, err := t.resolve(ctx)
 	if err != nil || tx == nil {
@@ -1058,10 +1135,14 @@ func (r *Resolver) Block(ctx context.Context, args struct {
 }) (*Block, error) {
 	var block *Block
 	if args.Number != nil {
-		if *args.Number < 0 {
-			return nil, nil
-		}
 		number := rpc.BlockNumber(*args.Number)
+		switch number {
+		case rpc.LatestBlockNumber, rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		default:
+			if number < 0 {
+				return nil, nil
+			}
+		}
 		numberOrHash := rpc.BlockNumberOrHashWithNumber(number)
 		block = &Block{
 			backend:      r.backend,
@@ -1096,15 +1177,21 @@ func (r *Resolver) Blocks(ctx context.Context, args struct {
 	From *Long
 	To   *Long
 }) ([]*Block, error) {
-	from := rpc.BlockNumber(*args.From)
+	from, err := r.resolveNumber(ctx, rpc.BlockNumber(*args.From))
+	if err != nil {
+		return nil, err
+	}
 
 	var to rpc.BlockNumber
 	if args.To != nil {
-		to = rpc.BlockNumber(*args.To)
+		to, err = r.resolveNumber(ctx, rpc.BlockNumber(*args.To))
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		to = rpc.BlockNumber(r.backend.CurrentBlock().Number().Int64())
 	}
-	if to < from {
+	if from < 0 || to < from {
 		return []*Block{}, nil
 	}
 	ret := make([]*Block, 0, to-from+1)
@@ -1118,6 +1205,23 @@ func (r *Resolver) Blocks(ctx context.Context, args struct {
 	return ret, nil
 }
 
+// resolveNumber converts the latest, finalized and safe tags to the number of
+// the block they are currently pointing at.
+func (r *Resolver) resolveNumber(ctx context.Context, number rpc.BlockNumber) (rpc.BlockNumber, error) {
+	switch number {
+	case rpc.LatestBlockNumber, rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		header, err := r.backend.HeaderByNumber(ctx, number)
+		if err != nil {
+			return 0, err
+		}
+		if header == nil {
+			return 0, errors.New("header not found")
+		}
+		return rpc.BlockNumber(header.Number.Int64()), nil
+	}
+	return number, nil
+}
+
 func (r *Resolver) Pending(ctx context.Context) *Pending {
 	return &Pending{r.backend}
 }
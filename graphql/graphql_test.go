@@ -35,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -126,6 +127,26 @@ func TestGraphQLBlockSerialization(t *testing.T) {
 			want: `{"errors":[{"message":"strconv.ParseInt: parsing \"a\": invalid syntax"}],"data":{}}`,
 			code: 400,
 		},
+		{ // Should resolve the block tags
+			body: `{"query": "{block(number:\"latest\"){number}}","variables": null}`,
+			want: `{"data":{"block":{"number":10}}}`,
+			code: 200,
+		},
+		{
+			body: `{"query": "{blocks(from:\"latest\"){number}}","variables": null}`,
+			want: `{"data":{"blocks":[{"number":10}]}}`,
+			code: 200,
+		},
+		{ // No block is finalized without fast finality
+			body: `{"query": "{block(number:\"finalized\"){number}}","variables": null}`,
+			want: `{"errors":[{"message":"header not found","path":["block"]}],"data":{"block":null}}`,
+			code: 400,
+		},
+		{
+			body: `{"query": "{block(number:-3){number}}","variables": null}`,
+			want: `{"data":{"block":null}}`,
+			code: 200,
+		},
 		{
 			body: `{"query": "{bleh{number}}","variables": null}"`,
 			want: `{"errors":[{"message":"Cannot query field \"bleh\" on type \"Query\".","locations":[{"line":1,"column":2}]}]}`,
@@ -161,6 +182,31 @@ func TestGraphQLBlockSerialization(t *testing.T) {
 	}
 }
 
+func TestLongBlockTags(t *testing.T) {
+	for _, tt := range []struct {
+		input interface{}
+		want  Long
+	}{
+		{"latest", Long(rpc.LatestBlockNumber)},
+		{"finalized", Long(rpc.FinalizedBlockNumber)},
+		{"safe", Long(rpc.SafeBlockNumber)},
+		{"10", 10},
+		{int32(10), 10},
+		// The negative numbers are not mistaken for the block tags
+		{"-3", invalidLong},
+		{int32(-4), invalidLong},
+		{int64(-1), invalidLong},
+	} {
+		var have Long
+		if err := have.UnmarshalGraphQL(tt.input); err != nil {
+			t.Fatalf("failed to unmarshal %v: %v", tt.input, err)
+		}
+		if have != tt.want {
+			t.Errorf("long mismatch for %v: have %d, want %d", tt.input, have, tt.want)
+		}
+	}
+}
+
 func TestGraphQLBlockSerializationEIP2718(t *testing.T) {
 	stack := createNode(t, true, true)
 	defer stack.Close()
@@ -28,7 +28,8 @@ const schema string = `
     # Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
     # 0x-prefixed hexadecimal.
     scalar BigInt
-    # Long is a 64 bit unsigned integer.
+    # Long is a 64 bit unsigned integer. Where a block number is expected, the
+    # "latest", "finalized" and "safe" block tags are accepted as well.
     scalar Long
 
     schema {
@@ -138,6 +139,16 @@ const schema string = `
         #Envelope transaction support
         type: Int
         accessList: [AccessTuple!]
+        # Payer is the account that paid the gas fee of a sponsored transaction.
+        # This is null for other transaction types.
+        payer(block: Long): Account
+        # ExpiredTime is the timestamp after which a sponsored transaction can
+        # no longer be included in a block, 0 means it never expires. This is
+        # null for other transaction types.
+        expiredTime: Long
+        payerR: BigInt
+        payerS: BigInt
+        payerV: BigInt
     }
 
     # BlockFilterCriteria encapsulates log filter criteria for a filter applied
@@ -322,7 +333,8 @@ const schema string = `
 
     type Query {
         # Block fetches an Ethereum block by number or by hash. If neither is
-        # supplied, the most recent known block is returned.
+        # supplied, the most recent known block is returned. The number may be
+        # one of the "latest", "finalized" and "safe" block tags.
         block(number: Long, hash: Bytes32): Block
         # Blocks returns all the blocks between two numbers, inclusive. If
         # to is not supplied, it defaults to the most recent known block.
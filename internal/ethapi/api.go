@@ -206,6 +206,34 @@ func (s *PublicTxPoolAPI) ContentFrom(addr common.Address) map[string]map[string
 	return content
 }
 
+// ContentFromPayer returns the sponsored transactions contained within the
+// transaction pool which are paid by the given payer, grouped by their senders.
+func (s *PublicTxPoolAPI) ContentFromPayer(payer common.Address) map[string]map[string]map[string]*RPCTransaction {
+	content := map[string]map[string]map[string]*RPCTransaction{
+		"pending": make(map[string]map[string]*RPCTransaction),
+		"queued":  make(map[string]map[string]*RPCTransaction),
+	}
+	pending, queue := s.b.TxPoolContentFromPayer(payer)
+	curHeader := s.b.CurrentHeader()
+	// Flatten the pending transactions
+	for account, txs := range pending {
+		dump := make(map[string]*RPCTransaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = newRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	// Flatten the queued transactions
+	for account, txs := range queue {
+		dump := make(map[string]*RPCTransaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = newRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+		}
+		content["queued"][account.Hex()] = dump
+	}
+	return content
+}
+
 // Status returns the number of pending and queued transaction in the pool.
 func (s *PublicTxPoolAPI) Status() map[string]hexutil.Uint {
 	pending, queue := s.b.Stats()
@@ -224,18 +252,39 @@ func (s *PublicTxPoolAPI) Inspect() map[string]map[string]map[string]string {
 	}
 	pending, queue := s.b.TxPoolContent()
 
-	// Define a formatter to flatten a transaction into a string
-	var format = func(tx *types.Transaction) string {
-		if to := tx.To(); to != nil {
-			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To().Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	// Flatten the pending transactions
+	for account, txs := range pending {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = inspectTransaction(tx)
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	// Flatten the queued transactions
+	for account, txs := range queue {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = inspectTransaction(tx)
 		}
-		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+		content["queued"][account.Hex()] = dump
+	}
+	return content
+}
+
+// InspectPayer retrieves the sponsored transactions paid by the given payer
+// and flattens them into an easily inspectable list, grouped by their senders.
+func (s *PublicTxPoolAPI) InspectPayer(payer common.Address) map[string]map[string]map[string]string {
+	content := map[string]map[string]map[string]string{
+		"pending": make(map[string]map[string]string),
+		"queued":  make(map[string]map[string]string),
 	}
+	pending, queue := s.b.TxPoolContentFromPayer(payer)
+
 	// Flatten the pending transactions
 	for account, txs := range pending {
 		dump := make(map[string]string)
 		for _, tx := range txs {
-			dump[fmt.Sprintf("%d", tx.Nonce())] = format(tx)
+			dump[fmt.Sprintf("%d", tx.Nonce())] = inspectTransaction(tx)
 		}
 		content["pending"][account.Hex()] = dump
 	}
@@ -243,13 +292,21 @@ func (s *PublicTxPoolAPI) Inspect() map[string]map[string]map[string]string {
 	for account, txs := range queue {
 		dump := make(map[string]string)
 		for _, tx := range txs {
-			dump[fmt.Sprintf("%d", tx.Nonce())] = format(tx)
+			dump[fmt.Sprintf("%d", tx.Nonce())] = inspectTransaction(tx)
 		}
 		content["queued"][account.Hex()] = dump
 	}
 	return content
 }
 
+// inspectTransaction flattens a transaction into an easily inspectable string.
+func inspectTransaction(tx *types.Transaction) string {
+	if to := tx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To().Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+}
+
 // PublicAccountAPI provides an API to access accounts managed by this node.
 // It offers only methods that can retrieve accounts.
 type PublicAccountAPI struct {
@@ -1432,6 +1489,63 @@ func newRPCTransactionFromBlockHash(b *types.Block, hash common.Hash, config *pa
 	return nil
 }
 
+// RPCInternalTransaction represents an internal transaction that will serialize
+// to the RPC representation of an internal transaction
+type RPCInternalTransaction struct {
+	Opcode          string         `json:"opcode"`
+	Type            string         `json:"type"`
+	Success         bool           `json:"success"`
+	Error           string         `json:"error,omitempty"`
+	Output          hexutil.Bytes  `json:"output"`
+	Order           hexutil.Uint64 `json:"order"`
+	TransactionHash common.Hash    `json:"transactionHash"`
+	Value           *hexutil.Big   `json:"value"`
+	Input           hexutil.Bytes  `json:"input"`
+	From            common.Address `json:"from"`
+	To              common.Address `json:"to"`
+	BlockNumber     hexutil.Uint64 `json:"blockNumber"`
+	BlockHash       common.Hash    `json:"blockHash"`
+	BlockTime       hexutil.Uint64 `json:"blockTime"`
+
+	// Only available since types.InternalTransactionVersion1
+	Depth       *hexutil.Uint64 `json:"depth,omitempty"`
+	ParentOrder *hexutil.Uint64 `json:"parentOrder,omitempty"`
+	Gas         *hexutil.Uint64 `json:"gas,omitempty"`
+	GasUsed     *hexutil.Uint64 `json:"gasUsed,omitempty"`
+}
+
+// NewRPCInternalTransaction returns an internal transaction that will serialize
+// to the RPC representation.
+func NewRPCInternalTransaction(internalTx *types.InternalTransaction) *RPCInternalTransaction {
+	result := &RPCInternalTransaction{
+		Opcode:  internalTx.Opcode,
+		Type:    internalTx.Type,
+		Success: internalTx.Success,
+		Error:   internalTx.Error,
+		Output:  hexutil.Bytes(internalTx.Output),
+	}
+	if body := internalTx.InternalTransactionBody; body != nil {
+		result.Order = hexutil.Uint64(body.Order)
+		result.TransactionHash = body.TransactionHash
+		result.Value = (*hexutil.Big)(body.Value)
+		result.Input = hexutil.Bytes(body.Input)
+		result.From = body.From
+		result.To = body.To
+		result.BlockNumber = hexutil.Uint64(body.Height)
+		result.BlockHash = body.BlockHash
+		result.BlockTime = hexutil.Uint64(body.BlockTime)
+	}
+	if internalTx.Version >= types.InternalTransactionVersion1 {
+		depth, gas, gasUsed := hexutil.Uint64(internalTx.Depth), hexutil.Uint64(internalTx.Gas), hexutil.Uint64(internalTx.GasUsed)
+		result.Depth, result.Gas, result.GasUsed = &depth, &gas, &gasUsed
+		if internalTx.ParentOrder != 0 {
+			parentOrder := hexutil.Uint64(internalTx.ParentOrder)
+			result.ParentOrder = &parentOrder
+		}
+	}
+	return result
+}
+
 // accessListResult returns an optional accesslist
 // Its the result of the `debug_createAccessList` RPC call.
 // It contains an error if the transaction itself failed.
@@ -1898,6 +2012,94 @@ func (s *PublicTransactionPoolAPI) SignTransaction(ctx context.Context, args Tra
 	return &SignTransactionResult{data, signed}, nil
 }
 
+// SignTransactionAsPayer signs the given sponsored transaction with the payer
+// account, the from address of args is the sender of the transaction. The
+// returned transaction carries the payer signature only, the sender then signs
+// it with SignSponsoredTransaction. The node needs to have the private key of
+// the payer account and it needs to be unlocked.
+func (s *PublicTransactionPoolAPI) SignTransactionAsPayer(ctx context.Context, payer common.Address, args TransactionArgs) (*SignTransactionResult, error) {
+	if !s.b.AccountManager().Config().EnableSigningMethods {
+		return nil, ErrMethodNotSupport
+	}
+	if args.From == nil {
+		return nil, fmt.Errorf("sender not specified")
+	}
+	if !args.sponsored() {
+		return nil, fmt.Errorf("expiredTime not specified")
+	}
+	if payer == *args.From {
+		return nil, types.ErrSamePayerSenderSponsoredTx
+	}
+	// Look up the wallet containing the requested payer
+	account := accounts.Account{Address: payer}
+
+	wallet, err := s.b.AccountManager().Find(account)
+	if err != nil {
+		return nil, err
+	}
+	if err := args.setDefaults(ctx, s.b); err != nil {
+		return nil, err
+	}
+	tx := args.toTransaction()
+	if err := checkTxFee(tx.GasPrice(), tx.Gas(), s.b.RPCTxFeeCap()); err != nil {
+		return nil, err
+	}
+	payerData, err := types.PayerSigningData(s.b.ChainConfig().ChainID, *args.From, tx)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := wallet.SignData(account, accounts.MimetypeSponsoredTxPayer, payerData)
+	if err != nil {
+		return nil, err
+	}
+	// The payer signature uses V on the form 0 or 1
+	if signature[64] == 27 || signature[64] == 28 {
+		signature[64] -= 27
+	}
+	args.PayerR = (*hexutil.Big)(new(big.Int).SetBytes(signature[:32]))
+	args.PayerS = (*hexutil.Big)(new(big.Int).SetBytes(signature[32:64]))
+	args.PayerV = (*hexutil.Big)(new(big.Int).SetUint64(uint64(signature[64])))
+
+	tx = args.toTransaction()
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &SignTransactionResult{data, tx}, nil
+}
+
+// SignSponsoredTransaction will sign the given sponsored transaction, which is
+// already signed by the given payer, with the from account. The node needs to
+// have the private key of the account corresponding with the given from address
+// and it needs to be unlocked.
+func (s *PublicTransactionPoolAPI) SignSponsoredTransaction(ctx context.Context, payer common.Address, args TransactionArgs) (*SignTransactionResult, error) {
+	if !args.sponsored() {
+		return nil, fmt.Errorf("expiredTime not specified")
+	}
+	if args.PayerV == nil || args.PayerR == nil || args.PayerS == nil {
+		return nil, fmt.Errorf("payer signature not specified")
+	}
+	result, err := s.SignTransaction(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	// Make sure the payer signature is signed by the expected payer for the
+	// signed transaction, otherwise the sender would agree to a transaction
+	// paid by someone else or rejected by the transaction pool.
+	signer := types.LatestSignerForChainID(s.b.ChainConfig().ChainID)
+	signedPayer, err := types.Payer(signer, result.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if signedPayer != payer {
+		return nil, fmt.Errorf("payer signature mismatch: have %s, want %s", signedPayer.Hex(), payer.Hex())
+	}
+	if payer == args.from() {
+		return nil, types.ErrSamePayerSenderSponsoredTx
+	}
+	return result, nil
+}
+
 // PendingTransactions returns the transactions that are in the transaction pool
 // and have a from address that is one of the accounts this node manages.
 func (s *PublicTransactionPoolAPI) PendingTransactions() ([]*RPCTransaction, error) {
@@ -2095,9 +2297,16 @@ func (api *PrivateDebugAPI) ChaindbCompact() error {
 	return nil
 }
 
-// SetHead rewinds the head of the blockchain to a previous block.
-func (api *PrivateDebugAPI) SetHead(number hexutil.Uint64) {
+// SetHead rewinds the head of the blockchain to a previous block. Rewinding
+// below the finalized block is refused unless force is set.
+func (api *PrivateDebugAPI) SetHead(ctx context.Context, number hexutil.Uint64, force *bool) error {
+	if force == nil || !*force {
+		if finalized, err := api.b.HeaderByNumber(ctx, rpc.FinalizedBlockNumber); err == nil && finalized != nil && uint64(number) < finalized.Number.Uint64() {
+			return fmt.Errorf("%w: head %d below finalized block %d, set force to rewind", core.ErrFinalizedBlockConflict, uint64(number), finalized.Number.Uint64())
+		}
+	}
 	api.b.SetHead(uint64(number))
+	return nil
 }
 
 // PublicNetAPI offers network related RPC methods
@@ -2126,6 +2335,70 @@ func (s *PublicNetAPI) Version() string {
 	return fmt.Sprintf("%d", s.networkVersion)
 }
 
+// PublicRoninAPI provides an API to inspect the Ronin specific restrictions
+// applied to the transactions.
+type PublicRoninAPI struct {
+	b Backend
+}
+
+// NewPublicRoninAPI creates a new Ronin API.
+func NewPublicRoninAPI(b Backend) *PublicRoninAPI {
+	return &PublicRoninAPI{b}
+}
+
+// IsBlacklisted returns whether the given address is blacklisted at the given
+// block, the transactions from, to or paid by a blacklisted address are rejected.
+func (s *PublicRoninAPI) IsBlacklisted(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (bool, error) {
+	statedb, header, err := s.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if statedb == nil || err != nil {
+		return false, err
+	}
+	config := s.b.ChainConfig()
+	if config.Consortium == nil || !config.IsOdysseus(header.Number) {
+		return false, nil
+	}
+	blacklisted := state.IsAddressBlacklisted(statedb, config.BlacklistContractAddress, &address)
+	return blacklisted, statedb.Error()
+}
+
+// WhitelistedDeployerResult is the whitelist status of a contract deployer.
+type WhitelistedDeployerResult struct {
+	Whitelisted     bool            `json:"whitelisted"`
+	WhitelistAll    bool            `json:"whitelistAll"`
+	Activated       bool            `json:"activated"`
+	ExpiryTimestamp *hexutil.Uint64 `json:"expiryTimestamp,omitempty"` // Only available since Antenna
+}
+
+// IsWhitelistedDeployer returns whether the given address is allowed to deploy
+// contracts at the given block, along with the expiry timestamp of its
+// whitelist.
+func (s *PublicRoninAPI) IsWhitelistedDeployer(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*WhitelistedDeployerResult, error) {
+	statedb, header, err := s.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if statedb == nil || err != nil {
+		return nil, err
+	}
+	config := s.b.ChainConfig()
+	if config.Consortium == nil {
+		return &WhitelistedDeployerResult{Whitelisted: true, WhitelistAll: true}, nil
+	}
+	result := new(WhitelistedDeployerResult)
+	if config.IsAntenna(header.Number) {
+		whitelistAll, activated, expiry := state.GetWhitelistedDeployerInfoV2(statedb, address, config.WhiteListDeployerContractV2Address)
+		expiryTimestamp := hexutil.Uint64(math.MaxUint64)
+		if expiry.IsUint64() {
+			expiryTimestamp = hexutil.Uint64(expiry.Uint64())
+		}
+		result.WhitelistAll = whitelistAll
+		result.Activated = activated
+		result.ExpiryTimestamp = &expiryTimestamp
+		result.Whitelisted = state.IsWhitelistedDeployerV2(statedb, address, header.Time, config.WhiteListDeployerContractV2Address)
+	} else {
+		result.Whitelisted = state.IsWhitelistedDeployer(statedb, address)
+		result.Activated = result.Whitelisted
+	}
+	return result, statedb.Error()
+}
+
 // checkTxFee is an internal function used to check whether the fee of
 // the given transaction is _reasonable_(under the cap).
 func checkTxFee(gasPrice *big.Int, gas uint64, cap float64) error {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
@@ -50,6 +51,7 @@ type testBackend struct {
 	db      ethdb.Database
 	chain   *core.BlockChain
 	pending *types.Block
+	accman  *accounts.Manager
 }
 
 func newTestBackend(t *testing.T, n int, gspec *core.Genesis, engine consensus.Engine, generator func(i int, b *core.BlockGen)) *testBackend {
@@ -85,7 +87,7 @@ func (b testBackend) FeeHistory(ctx context.Context, blockCount int, lastBlock r
 	return nil, nil, nil, nil, nil
 }
 func (b testBackend) ChainDb() ethdb.Database           { return b.db }
-func (b testBackend) AccountManager() *accounts.Manager { return nil }
+func (b testBackend) AccountManager() *accounts.Manager { return b.accman }
 func (b testBackend) ExtRPCEnabled() bool               { return false }
 func (b testBackend) RPCGasCap() uint64                 { return 10000000 }
 func (b testBackend) RPCEVMTimeout() time.Duration      { return time.Second }
@@ -215,6 +217,9 @@ func (b testBackend) TxPoolContent() (map[common.Address]types.Transactions, map
 func (b testBackend) TxPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions) {
 	panic("implement me")
 }
+func (b testBackend) TxPoolContentFromPayer(payer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	panic("implement me")
+}
 func (b testBackend) SubscribeNewTxsEvent(events chan<- core.NewTxsEvent) event.Subscription {
 	panic("implement me")
 }
@@ -518,6 +523,170 @@ func TestCall(t *testing.T) {
 	}
 }
 
+func TestSignSponsoredTransaction(t *testing.T) {
+	t.Parallel()
+	// Initialize test accounts, the payer pays for the transaction of the sender
+	var (
+		testAccounts = newAccounts(3)
+		sender       = testAccounts[0]
+		payer        = testAccounts[1]
+		other        = testAccounts[2]
+		genesis      = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: core.GenesisAlloc{
+				payer.addr: {Balance: big.NewInt(params.Ether)},
+			},
+		}
+	)
+	backend := newTestBackend(t, 1, genesis, ethash.NewFaker(), func(i int, b *core.BlockGen) {})
+
+	// Only the sender account is unlocked in the node
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(sender.key, "")
+	if err != nil {
+		t.Fatalf("failed to import sender key: %v", err)
+	}
+	if err := ks.Unlock(account, ""); err != nil {
+		t.Fatalf("failed to unlock sender account: %v", err)
+	}
+	backend.accman = accounts.NewManager(&accounts.Config{EnableSigningMethods: true}, ks)
+
+	server := rpc.NewServer()
+	defer server.Stop()
+	if err := server.RegisterName("eth", NewPublicTransactionPoolAPI(backend, new(AddrLocker))); err != nil {
+		t.Fatalf("failed to register API: %v", err)
+	}
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	var (
+		chainSigner = types.LatestSignerForChainID(genesis.Config.ChainID)
+		txdata      = &types.SponsoredTx{
+			ChainID:     genesis.Config.ChainID,
+			Nonce:       0,
+			GasTipCap:   big.NewInt(params.InitialBaseFee),
+			GasFeeCap:   big.NewInt(params.InitialBaseFee),
+			Gas:         params.TxGas,
+			To:          &other.addr,
+			Value:       big.NewInt(0),
+			ExpiredTime: 100,
+		}
+	)
+	// argsSignedBy returns the arguments of the transaction with the payer
+	// signature of the given account
+	argsSignedBy := func(key *ecdsa.PrivateKey) TransactionArgs {
+		r, s, v, err := types.PayerSign(key, chainSigner, sender.addr, txdata)
+		if err != nil {
+			t.Fatalf("failed to sign as payer: %v", err)
+		}
+		var (
+			gas         = hexutil.Uint64(txdata.Gas)
+			nonce       = hexutil.Uint64(txdata.Nonce)
+			expiredTime = hexutil.Uint64(txdata.ExpiredTime)
+		)
+		return TransactionArgs{
+			From:                 &sender.addr,
+			To:                   txdata.To,
+			Gas:                  &gas,
+			MaxFeePerGas:         (*hexutil.Big)(txdata.GasFeeCap),
+			MaxPriorityFeePerGas: (*hexutil.Big)(txdata.GasTipCap),
+			Value:                (*hexutil.Big)(txdata.Value),
+			Nonce:                &nonce,
+			ChainID:              (*hexutil.Big)(txdata.ChainID),
+			ExpiredTime:          &expiredTime,
+			PayerV:               (*hexutil.Big)(v),
+			PayerR:               (*hexutil.Big)(r),
+			PayerS:               (*hexutil.Big)(s),
+		}
+	}
+
+	// The transaction paid by the expected payer is signed by the sender
+	var result SignTransactionResult
+	if err := client.Call(&result, "eth_signSponsoredTransaction", payer.addr, argsSignedBy(payer.key)); err != nil {
+		t.Fatalf("failed to sign sponsored transaction: %v", err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(result.Raw); err != nil {
+		t.Fatalf("failed to decode signed transaction: %v", err)
+	}
+	if from, err := types.Sender(chainSigner, tx); err != nil || from != sender.addr {
+		t.Errorf("sender mismatch: have %s, want %s, err %v", from.Hex(), sender.addr.Hex(), err)
+	}
+	if from, err := types.Payer(chainSigner, tx); err != nil || from != payer.addr {
+		t.Errorf("payer mismatch: have %s, want %s, err %v", from.Hex(), payer.addr.Hex(), err)
+	}
+
+	// The transaction whose payer signature is not from the expected payer is
+	// refused
+	if err := client.Call(&result, "eth_signSponsoredTransaction", payer.addr, argsSignedBy(other.key)); err == nil {
+		t.Fatal("signed sponsored transaction with wrong payer signature")
+	}
+	// The sender can't be the payer
+	if err := client.Call(&result, "eth_signSponsoredTransaction", sender.addr, argsSignedBy(sender.key)); err == nil {
+		t.Fatal("signed sponsored transaction paid by the sender")
+	}
+}
+
+func TestSponsoredTransactionFees(t *testing.T) {
+	t.Parallel()
+	var (
+		testAccounts = newAccounts(2)
+		genesis      = &core.Genesis{Config: params.TestChainConfig}
+		backend      = newTestBackend(t, 1, genesis, ethash.NewFaker(), func(i int, b *core.BlockGen) {})
+		baseFee      = backend.CurrentHeader().BaseFee
+	)
+	newArgs := func(maxFee, maxTip *big.Int) TransactionArgs {
+		var (
+			gas         = hexutil.Uint64(params.TxGas)
+			nonce       = hexutil.Uint64(0)
+			expiredTime = hexutil.Uint64(100)
+		)
+		return TransactionArgs{
+			From:                 &testAccounts[0].addr,
+			To:                   &testAccounts[1].addr,
+			Gas:                  &gas,
+			Nonce:                &nonce,
+			ExpiredTime:          &expiredTime,
+			MaxFeePerGas:         (*hexutil.Big)(maxFee),
+			MaxPriorityFeePerGas: (*hexutil.Big)(maxTip),
+		}
+	}
+	var tests = []struct {
+		maxFee, maxTip *big.Int
+		want           *big.Int
+		wantErr        error
+	}{
+		// No fee given, both caps default to the suggested gas price
+		{nil, nil, baseFee, nil},
+		// One cap given, the other one is set to it
+		{big.NewInt(params.GWei), nil, big.NewInt(params.GWei), nil},
+		{nil, big.NewInt(params.GWei), big.NewInt(params.GWei), nil},
+		// Equal caps are kept, unequal caps are rejected
+		{big.NewInt(params.GWei), big.NewInt(params.GWei), big.NewInt(params.GWei), nil},
+		{big.NewInt(2 * params.GWei), big.NewInt(params.GWei), nil, core.ErrDifferentFeeCapTipCap},
+	}
+	for i, tt := range tests {
+		args := newArgs(tt.maxFee, tt.maxTip)
+		err := args.setDefaults(context.Background(), backend)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("test %d: failed to set defaults: %v", i, err)
+		}
+		tx := args.toTransaction()
+		if tx.Type() != types.SponsoredTxType {
+			t.Fatalf("test %d: transaction type mismatch: have %d, want %d", i, tx.Type(), types.SponsoredTxType)
+		}
+		if tx.GasFeeCap().Cmp(tt.want) != 0 || tx.GasTipCap().Cmp(tt.want) != 0 {
+			t.Errorf("test %d: fee mismatch: have fee cap %v tip cap %v, want %v", i, tx.GasFeeCap(), tx.GasTipCap(), tt.want)
+		}
+	}
+}
+
 type Account struct {
 	key  *ecdsa.PrivateKey
 	addr common.Address
@@ -81,6 +81,7 @@ type Backend interface {
 	Stats() (pending int, queued int)
 	TxPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
 	TxPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions)
+	TxPoolContentFromPayer(payer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
 	SubscribeNewTxsEvent(chan<- core.NewTxsEvent) event.Subscription
 
 	// Filter API
@@ -135,6 +136,11 @@ func GetAPIs(apiBackend Backend) []rpc.API {
 			Version:   "1.0",
 			Service:   NewPublicAccountAPI(apiBackend.AccountManager()),
 			Public:    true,
+		}, {
+			Namespace: "ronin",
+			Version:   "1.0",
+			Service:   NewPublicRoninAPI(apiBackend),
+			Public:    true,
 		}, {
 			Namespace: "personal",
 			Version:   "1.0",
@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -52,6 +53,13 @@ type TransactionArgs struct {
 	// Introduced by AccessListTxType transaction.
 	AccessList *types.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big      `json:"chainId,omitempty"`
+
+	// Introduced by SponsoredTxType transaction, the transaction is sponsored
+	// if ExpiredTime is set.
+	ExpiredTime *hexutil.Uint64 `json:"expiredTime,omitempty"`
+	PayerV      *hexutil.Big    `json:"payerV,omitempty"`
+	PayerR      *hexutil.Big    `json:"payerR,omitempty"`
+	PayerS      *hexutil.Big    `json:"payerS,omitempty"`
 }
 
 // from retrieves the transaction sender address.
@@ -73,11 +81,21 @@ func (arg *TransactionArgs) data() []byte {
 	return nil
 }
 
+// sponsored returns true if the arguments construct a sponsored transaction.
+func (arg *TransactionArgs) sponsored() bool {
+	return arg.ExpiredTime != nil
+}
+
 // setDefaults fills in default values for unspecified tx fields.
 func (args *TransactionArgs) setDefaults(ctx context.Context, b Backend) error {
 	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
 		return errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
 	}
+	if args.sponsored() {
+		if err := args.setSponsoredFeeDefaults(ctx, b); err != nil {
+			return err
+		}
+	}
 	// After london, default to 1559 unless gasPrice is set
 	head := b.CurrentHeader()
 	// If user specifies both maxPriorityfee and maxFee, then we do not
@@ -157,6 +175,11 @@ func (args *TransactionArgs) setDefaults(ctx context.Context, b Backend) error {
 			Data:                 (*hexutil.Bytes)(&data),
 			AccessList:           args.AccessList,
 		}
+		// The fee of sponsored transaction is paid by the payer, the
+		// sender may not have enough balance to cover it.
+		if args.sponsored() {
+			callArgs.GasPrice, callArgs.MaxFeePerGas, callArgs.MaxPriorityFeePerGas = nil, nil, nil
+		}
 		pendingBlockNr := rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber)
 		estimated, err := DoEstimateGas(ctx, b, callArgs, pendingBlockNr, nil, b.RPCGasCap())
 		if err != nil {
@@ -172,6 +195,39 @@ func (args *TransactionArgs) setDefaults(ctx context.Context, b Backend) error {
 	return nil
 }
 
+// setSponsoredFeeDefaults fills in the gas price of the sponsored transaction.
+// The sponsored transaction has a single gas price, so its fee cap and tip cap
+// must be equal. The missing cap is set to the given one, or the gas price is
+// suggested as for a legacy transaction if no fee is given.
+func (args *TransactionArgs) setSponsoredFeeDefaults(ctx context.Context, b Backend) error {
+	if args.AccessList != nil {
+		return errors.New("accessList is not supported in sponsored transaction")
+	}
+	switch {
+	case args.MaxFeePerGas == nil && args.MaxPriorityFeePerGas != nil:
+		args.MaxFeePerGas = args.MaxPriorityFeePerGas
+	case args.MaxFeePerGas != nil && args.MaxPriorityFeePerGas == nil:
+		args.MaxPriorityFeePerGas = args.MaxFeePerGas
+	}
+	if args.MaxFeePerGas != nil {
+		if args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) != 0 {
+			return fmt.Errorf("%w: maxFeePerGas (%v) != maxPriorityFeePerGas (%v)", core.ErrDifferentFeeCapTipCap, args.MaxFeePerGas, args.MaxPriorityFeePerGas)
+		}
+		return nil
+	}
+	if args.GasPrice == nil {
+		price, err := b.SuggestGasTipCap(ctx)
+		if err != nil {
+			return err
+		}
+		if head := b.CurrentHeader(); b.ChainConfig().IsLondon(head.Number) {
+			price.Add(price, head.BaseFee)
+		}
+		args.GasPrice = (*hexutil.Big)(price)
+	}
+	return nil
+}
+
 // ToMessage converts the transaction arguments to the Message type used by the
 // core evm. This method is used in calls and traces that do not require a real
 // live transaction.
@@ -248,6 +304,29 @@ func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (t
 func (args *TransactionArgs) toTransaction() *types.Transaction {
 	var data types.TxData
 	switch {
+	case args.sponsored():
+		gasFeeCap, gasTipCap := (*big.Int)(args.MaxFeePerGas), (*big.Int)(args.MaxPriorityFeePerGas)
+		if args.GasPrice != nil {
+			gasFeeCap, gasTipCap = (*big.Int)(args.GasPrice), (*big.Int)(args.GasPrice)
+		}
+		payerV, payerR, payerS := new(big.Int), new(big.Int), new(big.Int)
+		if args.PayerV != nil && args.PayerR != nil && args.PayerS != nil {
+			payerV, payerR, payerS = (*big.Int)(args.PayerV), (*big.Int)(args.PayerR), (*big.Int)(args.PayerS)
+		}
+		data = &types.SponsoredTx{
+			To:          args.To,
+			ChainID:     (*big.Int)(args.ChainID),
+			Nonce:       uint64(*args.Nonce),
+			Gas:         uint64(*args.Gas),
+			GasFeeCap:   gasFeeCap,
+			GasTipCap:   gasTipCap,
+			Value:       (*big.Int)(args.Value),
+			Data:        args.data(),
+			ExpiredTime: uint64(*args.ExpiredTime),
+			PayerV:      payerV,
+			PayerR:      payerR,
+			PayerS:      payerS,
+		}
 	case args.MaxFeePerGas != nil:
 		al := types.AccessList{}
 		if args.AccessList != nil {
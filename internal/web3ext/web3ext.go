@@ -30,6 +30,8 @@ var Modules = map[string]string{
 	"txpool":   TxpoolJs,
 	"les":      LESJs,
 	"vflux":    VfluxJs,
+	"monitor":  MonitorJs,
+	"ronin":    RoninJs,
 }
 
 const CliqueJs = `
@@ -242,7 +244,8 @@ web3._extend({
 		new web3._extend.Method({
 			name: 'setHead',
 			call: 'debug_setHead',
-			params: 1
+			params: 2,
+			inputFormatter: [null, null]
 		}),
 		new web3._extend.Method({
 			name: 'seedHash',
@@ -503,6 +506,39 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputTransactionFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getInternalTransactionsByTxHash',
+			call: 'eth_getInternalTransactionsByTxHash',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getInternalTransactionsByBlockNumber',
+			call: 'eth_getInternalTransactionsByBlockNumber',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getInternalTransactionsByBlockHash',
+			call: 'eth_getInternalTransactionsByBlockHash',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getInternalTransactions',
+			call: 'eth_getInternalTransactions',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'signTransactionAsPayer',
+			call: 'eth_signTransactionAsPayer',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputTransactionFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'signSponsoredTransaction',
+			call: 'eth_signSponsoredTransaction',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputTransactionFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'estimateGas',
 			call: 'eth_estimateGas',
@@ -771,6 +807,16 @@ web3._extend({
 			call: 'txpool_contentFrom',
 			params: 1,
 		}),
+		new web3._extend.Method({
+			name: 'contentFromPayer',
+			call: 'txpool_contentFromPayer',
+			params: 1,
+		}),
+		new web3._extend.Method({
+			name: 'inspectPayer',
+			call: 'txpool_inspectPayer',
+			params: 1,
+		}),
 	]
 });
 `
@@ -859,3 +905,39 @@ web3._extend({
 	]
 });
 `
+
+const MonitorJs = `
+web3._extend({
+	property: 'monitor',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'getDoubleSignEvidence',
+			call: 'monitor_getDoubleSignEvidence',
+			params: 2,
+			inputFormatter: [web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal]
+		}),
+	]
+});
+`
+
+const RoninJs = `
+web3._extend({
+	property: 'ronin',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'isBlacklisted',
+			call: 'ronin_isBlacklisted',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'isWhitelistedDeployer',
+			call: 'ronin_isWhitelistedDeployer',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+	]
+});
+`
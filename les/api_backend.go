@@ -72,6 +72,10 @@ func (b *LesApiBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumb
 	if number == rpc.LatestBlockNumber {
 		return b.eth.blockchain.CurrentHeader(), nil
 	}
+	// The light client does not track the finality votes
+	if number == rpc.FinalizedBlockNumber || number == rpc.SafeBlockNumber {
+		return nil, errors.New("finalized and safe blocks are not supported by light client")
+	}
 	return b.eth.blockchain.GetHeaderByNumberOdr(ctx, uint64(number))
 }
 
@@ -232,6 +236,10 @@ func (b *LesApiBackend) TxPoolContentFrom(addr common.Address) (types.Transactio
 	return b.eth.txPool.ContentFrom(addr)
 }
 
+func (b *LesApiBackend) TxPoolContentFromPayer(payer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	return b.eth.txPool.ContentFromPayer(payer)
+}
+
 func (b *LesApiBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
 	return b.eth.txPool.SubscribeNewTxsEvent(ch)
 }
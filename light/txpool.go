@@ -69,6 +69,7 @@ type TxPool struct {
 
 	istanbul bool // Fork indicator whether we are in the istanbul stage.
 	eip2718  bool // Fork indicator whether we are in the eip2718 stage.
+	miko     bool // Fork indicator whether we are using sponsored transactions.
 }
 
 // TxRelayBackend provides an interface to the mechanism that forwards transacions
@@ -102,6 +103,7 @@ func NewTxPool(config *params.ChainConfig, chain *LightChain, relay TxRelayBacke
 		chainDb:     chain.Odr().Database(),
 		head:        chain.CurrentHeader().Hash(),
 		clearIdx:    chain.CurrentHeader().Number.Uint64(),
+		miko:        config.IsMiko(new(big.Int).Add(chain.CurrentHeader().Number, big.NewInt(1))),
 	}
 	// Subscribe events from blockchain
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
@@ -315,6 +317,7 @@ func (pool *TxPool) setNewHead(head *types.Header) {
 	next := new(big.Int).Add(head.Number, big.NewInt(1))
 	pool.istanbul = pool.config.IsIstanbul(next)
 	pool.eip2718 = pool.config.IsBerlin(next)
+	pool.miko = pool.config.IsMiko(next)
 }
 
 // Stop stops the light transaction pool
@@ -350,6 +353,10 @@ func (pool *TxPool) validateTx(ctx context.Context, tx *types.Transaction) error
 		err  error
 	)
 
+	// Reject sponsored transactions until Miko hardfork.
+	if !pool.miko && tx.Type() == types.SponsoredTxType {
+		return core.ErrTxTypeNotSupported
+	}
 	// Validate the transaction sender and it's sig. Throw
 	// if the from fields is invalid.
 	if from, err = types.Sender(pool.signer, tx); err != nil {
@@ -375,10 +382,38 @@ func (pool *TxPool) validateTx(ctx context.Context, tx *types.Transaction) error
 		return core.ErrNegativeValue
 	}
 
-	// Transactor should have enough funds to cover the costs
-	// cost == V + GP * GL
-	if b := currentState.GetBalance(from); b.Cmp(tx.Cost()) < 0 {
-		return core.ErrInsufficientFunds
+	if tx.Type() == types.SponsoredTxType {
+		// Currently, these 2 fields must be the same in sponsored transaction.
+		if tx.GasFeeCap().Cmp(tx.GasTipCap()) != 0 {
+			return core.ErrDifferentFeeCapTipCap
+		}
+		// Ensure sponsored transaction is not expired
+		if expiredTime := tx.ExpiredTime(); expiredTime != 0 && expiredTime <= header.Time {
+			return core.ErrExpiredSponsoredTx
+		}
+		payer, err := types.Payer(pool.signer, tx)
+		if err != nil {
+			return core.ErrInvalidPayer
+		}
+		if payer == from {
+			return types.ErrSamePayerSenderSponsoredTx
+		}
+		// Payer should have enough funds to cover the gas fee
+		// gas fee == gas fee cap * gas limit
+		gasFee := new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas()))
+		if b := currentState.GetBalance(payer); b.Cmp(gasFee) < 0 {
+			return core.ErrInsufficientPayerFunds
+		}
+		// Sender should have enough funds to cover the value
+		if b := currentState.GetBalance(from); b.Cmp(tx.Value()) < 0 {
+			return core.ErrInsufficientSenderFunds
+		}
+	} else {
+		// Transactor should have enough funds to cover the costs
+		// cost == V + GP * GL
+		if b := currentState.GetBalance(from); b.Cmp(tx.Cost()) < 0 {
+			return core.ErrInsufficientFunds
+		}
 	}
 
 	// Should supply enough intrinsic gas
@@ -524,6 +559,27 @@ func (pool *TxPool) ContentFrom(addr common.Address) (types.Transactions, types.
 	return pending, types.Transactions{}
 }
 
+// ContentFromPayer retrieves the data content of the transaction pool, returning
+// the pending as well as queued sponsored transactions paid by this address,
+// grouped by their senders.
+func (pool *TxPool) ContentFromPayer(payer common.Address) (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	// Retrieve the pending transactions of the payer and group by account
+	pending := make(map[common.Address]types.Transactions)
+	for _, tx := range pool.pending {
+		if addr, err := types.Payer(pool.signer, tx); err != nil || addr != payer {
+			continue
+		}
+		account, _ := types.Sender(pool.signer, tx)
+		pending[account] = append(pending[account], tx)
+	}
+	// There are no queued transactions in a light pool, just return an empty map
+	queued := make(map[common.Address]types.Transactions)
+	return pending, queued
+}
+
 // RemoveTransactions removes all given transactions from the pool.
 func (pool *TxPool) RemoveTransactions(txs types.Transactions) {
 	pool.mu.Lock()
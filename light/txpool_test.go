@@ -18,6 +18,8 @@ package light
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"errors"
 	"math"
 	"math/big"
 	"testing"
@@ -29,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -144,3 +147,74 @@ func TestTxPool(t *testing.T) {
 		}
 	}
 }
+
+func TestTxPoolSponsoredTx(t *testing.T) {
+	var (
+		sdb    = rawdb.NewMemoryDatabase()
+		ldb    = rawdb.NewMemoryDatabase()
+		config = *params.TestChainConfig
+		gspec  = core.Genesis{
+			Config:    &config,
+			Timestamp: 100,
+			Alloc:     core.GenesisAlloc{testBankAddress: {Balance: testBankFunds}},
+			BaseFee:   big.NewInt(params.InitialBaseFee),
+		}
+	)
+	config.MikoBlock = common.Big0
+	gspec.MustCommit(sdb)
+	gspec.MustCommit(ldb)
+
+	odr := &testOdr{sdb: sdb, ldb: ldb, indexerConfig: TestClientIndexerConfig}
+	relay := &testTxRelay{
+		send:    make(chan int, 1),
+		discard: make(chan int, 1),
+		mined:   make(chan int, 1),
+	}
+	lightchain, _ := NewLightChain(odr, &config, ethash.NewFullFaker(), nil)
+	pool := NewTxPool(&config, lightchain, relay)
+	defer pool.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	signer := types.LatestSigner(&config)
+	sponsoredTx := func(payerKey, senderKey *ecdsa.PrivateKey, expiredTime uint64) *types.Transaction {
+		innerTx := &types.SponsoredTx{
+			ChainID:     config.ChainID,
+			GasTipCap:   big.NewInt(params.InitialBaseFee),
+			GasFeeCap:   big.NewInt(params.InitialBaseFee),
+			Gas:         params.TxGas,
+			To:          &acc1Addr,
+			ExpiredTime: expiredTime,
+		}
+		var err error
+		innerTx.PayerR, innerTx.PayerS, innerTx.PayerV, err = types.PayerSign(payerKey, signer, crypto.PubkeyToAddress(senderKey.PublicKey), innerTx)
+		if err != nil {
+			t.Fatalf("Payer fails to sign transaction, err %s", err)
+		}
+		tx, err := types.SignNewTx(senderKey, signer, innerTx)
+		if err != nil {
+			t.Fatalf("Fail to sign transaction, err %s", err)
+		}
+		return tx
+	}
+	for i, tt := range []struct {
+		tx  *types.Transaction
+		err error
+	}{
+		{sponsoredTx(acc1Key, acc1Key, 0), types.ErrSamePayerSenderSponsoredTx},
+		{sponsoredTx(testBankKey, acc1Key, 100), core.ErrExpiredSponsoredTx},
+		{sponsoredTx(acc1Key, testBankKey, 0), core.ErrInsufficientPayerFunds},
+		{sponsoredTx(testBankKey, acc1Key, 200), nil},
+	} {
+		if err := pool.Add(ctx, tt.tx); !errors.Is(err, tt.err) {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+	if got := <-relay.send; got != 1 {
+		t.Errorf("relay.Send expected len = %d, got %d", 1, got)
+	}
+	pending, _ := pool.ContentFromPayer(testBankAddress)
+	if txs := pending[acc1Addr]; len(txs) != 1 {
+		t.Errorf("payer content mismatch: have %d, want %d", len(txs), 1)
+	}
+}
@@ -0,0 +1,179 @@
+package monitor
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/time/rate"
+)
+
+const (
+	DefaultAlertRateLimit   = 30
+	DefaultAlertDedupWindow = 10 * time.Minute
+
+	// alertQueueSize is the number of alerts waiting to be sent to the sinks,
+	// the alerts are dropped when the queue is full.
+	alertQueueSize = 64
+)
+
+// Alerter sends the alert of the detected violation to an external sink.
+type Alerter interface {
+	Alert(header, body string)
+}
+
+// AlertConfig contains the configurations of alert sinks, the sink whose
+// configuration is empty is disabled.
+type AlertConfig struct {
+	SlackWebhook        string        // Slack incoming webhook URL
+	Webhook             string        // Generic webhook URL that receives the alert in JSON
+	PagerDutyRoutingKey string        // PagerDuty Events API v2 routing key
+	File                string        // File path that the alerts are appended to
+	Syslog              bool          // Send the alerts to the local syslog daemon
+	Metrics             bool          // Count the alerts in metrics registry
+	RateLimit           int           // Maximum number of alerts sent per minute, 0 means unlimited
+	DedupWindow         time.Duration // The same alert is sent once in this duration, 0 means no deduplication
+}
+
+// NewAlerter creates an alerter which dispatches the alerts to all configured
+// sinks. The metrics sink counts all alerts while the others are subject to rate
+// limiting and deduplication. It returns nil if there is no sink configured.
+func NewAlerter(config *AlertConfig) (Alerter, error) {
+	var (
+		sinks    []Alerter
+		counters Alerter
+	)
+
+	if config.SlackWebhook != "" {
+		sinks = append(sinks, NewSlackAlert(config.SlackWebhook))
+	}
+	if config.Webhook != "" {
+		sinks = append(sinks, newWebhookAlerter(config.Webhook))
+	}
+	if config.PagerDutyRoutingKey != "" {
+		sinks = append(sinks, newPagerDutyAlerter(config.PagerDutyRoutingKey))
+	}
+	if config.File != "" {
+		alerter, err := newFileAlerter(config.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, alerter)
+	}
+	if config.Syslog {
+		alerter, err := newSyslogAlerter()
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, alerter)
+	}
+	if config.Metrics {
+		counters = newMetricsAlerter()
+	}
+	if len(sinks) == 0 && counters == nil {
+		return nil, nil
+	}
+
+	dispatcher := &alertDispatcher{
+		sinks:       sinks,
+		counters:    counters,
+		dedupWindow: config.DedupWindow,
+		lastSent:    make(map[string]time.Time),
+		alertCh:     make(chan alert, alertQueueSize),
+	}
+	if config.RateLimit > 0 {
+		dispatcher.limiter = rate.NewLimiter(rate.Every(time.Minute/time.Duration(config.RateLimit)), config.RateLimit)
+	}
+	go dispatcher.loop()
+	return dispatcher, nil
+}
+
+// alert is the alert queued to be sent to the sinks
+type alert struct {
+	header string
+	body   string
+}
+
+// alertDispatcher sends the alerts to multiple sinks with rate limiting and
+// deduplication. The alerts are sent to the sinks in the background, so that
+// the monitors calling Alert are never held up by a slow sink.
+type alertDispatcher struct {
+	sinks    []Alerter
+	counters Alerter
+
+	lock        sync.Mutex
+	limiter     *rate.Limiter
+	dedupWindow time.Duration
+	lastSent    map[string]time.Time
+
+	alertCh chan alert
+}
+
+// Alert counts the alert and queues it to be sent to the sinks, the alert is
+// dropped if the queue is full.
+func (dispatcher *alertDispatcher) Alert(header, body string) {
+	if dispatcher.counters != nil {
+		dispatcher.counters.Alert(header, body)
+	}
+	if err := dispatcher.allow(header, body, time.Now()); err != nil {
+		log.Debug("Alert is suppressed", "header", header, "reason", err)
+		return
+	}
+	select {
+	case dispatcher.alertCh <- alert{header: header, body: body}:
+	default:
+		log.Warn("Alert queue is full, drop alert", "header", header)
+	}
+}
+
+// loop sends the queued alerts to the sinks one by one.
+func (dispatcher *alertDispatcher) loop() {
+	for alert := range dispatcher.alertCh {
+		for _, sink := range dispatcher.sinks {
+			sink.Alert(alert.header, alert.body)
+		}
+	}
+}
+
+var (
+	errNoSink           = errors.New("no alert sink")
+	errDuplicatedAlert  = errors.New("duplicated alert")
+	errAlertRateLimited = errors.New("rate limited")
+)
+
+func (dispatcher *alertDispatcher) allow(header, body string, now time.Time) error {
+	if len(dispatcher.sinks) == 0 {
+		return errNoSink
+	}
+
+	dispatcher.lock.Lock()
+	defer dispatcher.lock.Unlock()
+
+	var key string
+	if dispatcher.dedupWindow > 0 {
+		key = alertKey(header, body)
+		if sent, ok := dispatcher.lastSent[key]; ok && now.Sub(sent) < dispatcher.dedupWindow {
+			return errDuplicatedAlert
+		}
+	}
+	if dispatcher.limiter != nil && !dispatcher.limiter.AllowN(now, 1) {
+		return errAlertRateLimited
+	}
+	if dispatcher.dedupWindow > 0 {
+		// Drop the expired entries to keep the map small
+		for k, sent := range dispatcher.lastSent {
+			if now.Sub(sent) >= dispatcher.dedupWindow {
+				delete(dispatcher.lastSent, k)
+			}
+		}
+		dispatcher.lastSent[key] = now
+	}
+	return nil
+}
+
+// alertKey returns the identifier of the alert used for deduplication
+func alertKey(header, body string) string {
+	return crypto.Keccak256Hash([]byte(header), []byte(body)).Hex()
+}
@@ -0,0 +1,85 @@
+package monitor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type mockAlerter struct {
+	alerts []string
+}
+
+func (alerter *mockAlerter) Alert(header, body string) {
+	alerter.alerts = append(alerter.alerts, header)
+}
+
+func TestAlertDispatcher(t *testing.T) {
+	sink, counters := &mockAlerter{}, &mockAlerter{}
+	dispatcher := &alertDispatcher{
+		sinks:       []Alerter{sink},
+		counters:    counters,
+		limiter:     rate.NewLimiter(rate.Every(time.Minute), 2),
+		dedupWindow: time.Minute,
+		lastSent:    make(map[string]time.Time),
+		alertCh:     make(chan alert, 2),
+	}
+
+	now := time.Now()
+	if err := dispatcher.allow("header 1", "body", now); err != nil {
+		t.Fatalf("Expect alert to be allowed, got %s", err)
+	}
+	if err := dispatcher.allow("header 1", "body", now); err != errDuplicatedAlert {
+		t.Fatalf("Expect %s, got %v", errDuplicatedAlert, err)
+	}
+	if err := dispatcher.allow("header 2", "body", now); err != nil {
+		t.Fatalf("Expect alert to be allowed, got %s", err)
+	}
+	if err := dispatcher.allow("header 3", "body", now); err != errAlertRateLimited {
+		t.Fatalf("Expect %s, got %v", errAlertRateLimited, err)
+	}
+	// The duplicated alert is allowed after the window
+	if err := dispatcher.allow("header 1", "body", now.Add(2*time.Minute)); err != nil {
+		t.Fatalf("Expect alert to be allowed, got %s", err)
+	}
+
+	dispatcher.Alert("header 4", "body")
+	dispatcher.Alert("header 4", "body")
+	if len(dispatcher.alertCh) != 1 {
+		t.Fatalf("Expect 1 queued alert, got %d", len(dispatcher.alertCh))
+	}
+	if len(counters.alerts) != 2 {
+		t.Fatalf("Expect 2 alerts counted, got %d", len(counters.alerts))
+	}
+
+	// The alerts are dropped when the queue is full instead of waiting
+	dispatcher.limiter = nil
+	dispatcher.Alert("header 5", "body")
+	dispatcher.Alert("header 6", "body")
+	if len(dispatcher.alertCh) != 2 {
+		t.Fatalf("Expect 2 queued alerts, got %d", len(dispatcher.alertCh))
+	}
+
+	// The queued alerts are sent to the sinks in the background
+	close(dispatcher.alertCh)
+	dispatcher.loop()
+	if want := []string{"header 4", "header 5"}; !reflect.DeepEqual(sink.alerts, want) {
+		t.Fatalf("Expect alerts %v in sink, got %v", want, sink.alerts)
+	}
+}
+
+func TestNewAlerter(t *testing.T) {
+	alerter, err := NewAlerter(&AlertConfig{RateLimit: DefaultAlertRateLimit})
+	if err != nil {
+		t.Fatalf("Failed to create alerter, err %s", err)
+	}
+	if alerter != nil {
+		t.Fatalf("Expect no alerter when there is no sink")
+	}
+
+	if name := metricName("Fast finality rule is violated"); name != "fast_finality_rule_is_violated" {
+		t.Fatalf("Unexpected metric name %s", name)
+	}
+}
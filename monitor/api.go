@@ -0,0 +1,40 @@
+package monitor
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// maxEvidenceQueryRange is the maximum number of blocks can be queried in
+// one GetDoubleSignEvidence request.
+const maxEvidenceQueryRange = 100_000
+
+var errInvalidQueryRange = errors.New("invalid block range")
+
+// PublicMonitorAPI provides an API to access the data collected by monitors.
+type PublicMonitorAPI struct {
+	db ethdb.Database
+}
+
+// NewPublicMonitorAPI creates a new monitor API.
+func NewPublicMonitorAPI(db ethdb.Database) *PublicMonitorAPI {
+	return &PublicMonitorAPI{db: db}
+}
+
+// GetDoubleSignEvidence returns the double sign evidences detected by double
+// sign monitor in the block range [fromBlock, toBlock].
+func (api *PublicMonitorAPI) GetDoubleSignEvidence(fromBlock, toBlock hexutil.Uint64) ([]*types.DoubleSignEvidence, error) {
+	if fromBlock > toBlock || toBlock-fromBlock > maxEvidenceQueryRange {
+		return nil, errInvalidQueryRange
+	}
+
+	evidences := rawdb.ReadDoubleSignEvidences(api.db, uint64(fromBlock), uint64(toBlock))
+	if evidences == nil {
+		evidences = []*types.DoubleSignEvidence{}
+	}
+	return evidences, nil
+}
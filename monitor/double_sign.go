@@ -3,26 +3,54 @@ package monitor
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	slashIndicator "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/slash_indicator"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
 	lru "github.com/hashicorp/golang-lru"
 )
 
 const monitorBlockRange = 20
 
+var errInvalidDoubleSignProof = errors.New("invalid double sign proof")
+
 type DoubleSignMonitor struct {
 	observerdBlocks *lru.Cache
+	db              ethdb.KeyValueWriter
+	chainId         *big.Int
+	alerter         Alerter
+	submitter       *SlashingSubmitter
 }
 
-func NewDoubleSignMonitor() (*DoubleSignMonitor, error) {
+// NewDoubleSignMonitor creates a double sign monitor, the detected evidences
+// are persisted to db if it is provided. The alerter and submitter are optional,
+// if provided, the evidences are sent to the alerter and submitted to
+// SlashIndicator contract.
+func NewDoubleSignMonitor(
+	db ethdb.KeyValueWriter,
+	chainId *big.Int,
+	alerter Alerter,
+	submitter *SlashingSubmitter,
+) (*DoubleSignMonitor, error) {
 	observerdBlocks, err := lru.New(monitorBlockRange)
 	if err != nil {
 		return nil, err
 	}
 	monitor := DoubleSignMonitor{
 		observerdBlocks: observerdBlocks,
+		db:              db,
+		chainId:         chainId,
+		alerter:         alerter,
+		submitter:       submitter,
 	}
 
 	return &monitor, nil
@@ -36,19 +64,122 @@ func getSignature(blockHeader *types.Header) string {
 func (monitor *DoubleSignMonitor) CheckDoubleSign(blockHeader *types.Header) {
 	if rawBlockHeader, ok := monitor.observerdBlocks.Get(blockHeader.ParentHash); ok {
 		blockHeaders, _ := rawBlockHeader.([]*types.Header)
+		for _, header := range blockHeaders {
+			if bytes.Equal(header.Hash().Bytes(), blockHeader.Hash().Bytes()) {
+				return
+			}
+		}
 		for _, header := range blockHeaders {
 			// Simple check for monitoring only
-			if !bytes.Equal(header.Hash().Bytes(), blockHeader.Hash().Bytes()) &&
-				bytes.Equal(header.Coinbase[:], blockHeader.Coinbase[:]) {
+			if bytes.Equal(header.Coinbase[:], blockHeader.Coinbase[:]) {
 				log.Error("Double sign detected", "block number", header.Number, "signer", header.Coinbase,
 					"block 1 hash", header.Hash().Hex(), "block 1 signature", getSignature(header),
 					"block 2 hash", blockHeader.Hash().Hex(), "block 2 signature", getSignature(blockHeader),
 				)
+				monitor.handleEvidence(&types.DoubleSignEvidence{
+					Signer:  header.Coinbase,
+					Header1: header,
+					Header2: blockHeader,
+				})
 				break
 			}
 		}
+		monitor.observerdBlocks.Add(blockHeader.ParentHash, append(blockHeaders, blockHeader))
 	} else {
 		blockHeaders := []*types.Header{blockHeader}
 		monitor.observerdBlocks.Add(blockHeader.ParentHash, blockHeaders)
 	}
 }
+
+func (monitor *DoubleSignMonitor) handleEvidence(evidence *types.DoubleSignEvidence) {
+	if monitor.alerter != nil {
+		alertBody := fmt.Sprintf(
+			"- Signer: %s\n"+
+				"- Block number: %d\n"+
+				"- Block 1 hash: %s\n"+
+				"- Block 1 signature: %s\n"+
+				"- Block 2 hash: %s\n"+
+				"- Block 2 signature: %s\n",
+			evidence.Signer.Hex(),
+			evidence.Number(),
+			evidence.Header1.Hash().Hex(),
+			getSignature(evidence.Header1),
+			evidence.Header2.Hash().Hex(),
+			getSignature(evidence.Header2),
+		)
+		monitor.alerter.Alert("Double sign detected", alertBody)
+	}
+	if monitor.db != nil {
+		rawdb.WriteDoubleSignEvidence(monitor.db, evidence)
+	}
+	if monitor.submitter == nil {
+		return
+	}
+
+	proof, err := NewDoubleSignProof(evidence, monitor.chainId)
+	if err == nil {
+		err = proof.Verify()
+	}
+	if err != nil {
+		log.Error("Invalid double sign proof, skip submitting", "signer", evidence.Signer,
+			"block number", evidence.Number(), "err", err)
+		return
+	}
+	monitor.submitter.SubmitDoubleSignProof(proof)
+}
+
+// DoubleSignProof is the double sign evidence in the format that
+// slashDoubleSign method of SlashIndicator contract accepts.
+type DoubleSignProof struct {
+	Evidence *types.DoubleSignEvidence
+	Header1  []byte
+	Header2  []byte
+}
+
+// NewDoubleSignProof encodes the headers of evidence for submission.
+func NewDoubleSignProof(evidence *types.DoubleSignEvidence, chainId *big.Int) (*DoubleSignProof, error) {
+	header1, err := vm.EncodeDoubleSignHeader(evidence.Header1, chainId)
+	if err != nil {
+		return nil, err
+	}
+	header2, err := vm.EncodeDoubleSignHeader(evidence.Header2, chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DoubleSignProof{
+		Evidence: evidence,
+		Header1:  header1,
+		Header2:  header2,
+	}, nil
+}
+
+// Verify checks the proof with the same rules as validatingDoubleSignProof
+// precompile, so that we don't submit the proof that is rejected by the contract.
+func (proof *DoubleSignProof) Verify() error {
+	if !vm.ValidateDoubleSignProof(proof.Evidence.Signer, proof.Header1, proof.Header2) {
+		return errInvalidDoubleSignProof
+	}
+	return nil
+}
+
+// Key returns the identifier of the proof, it is used to avoid submitting the
+// same offense multiple times.
+func (proof *DoubleSignProof) Key() string {
+	return proof.Evidence.Signer.Hex() + "-" + new(big.Int).SetUint64(proof.Evidence.Number()).String()
+}
+
+// Offender returns the signer of the conflicting headers.
+func (proof *DoubleSignProof) Offender() common.Address {
+	return proof.Evidence.Signer
+}
+
+// Number returns the block number of the conflicting headers.
+func (proof *DoubleSignProof) Number() uint64 {
+	return proof.Evidence.Number()
+}
+
+// slashingTx creates the transaction calling slashDoubleSign method.
+func (proof *DoubleSignProof) slashingTx(contract *slashIndicator.SlashIndicatorTransactor, opts *bind.TransactOpts) (*types.Transaction, error) {
+	return contract.SlashDoubleSign(opts, proof.Evidence.Signer, proof.Header1, proof.Header2)
+}
@@ -0,0 +1,57 @@
+package monitor
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestCheckDoubleSign(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	monitor, err := NewDoubleSignMonitor(db, big.NewInt(2021), nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create double sign monitor, err %s", err)
+	}
+
+	newHeader := func(coinbase common.Address, time uint64) *types.Header {
+		return &types.Header{
+			ParentHash: common.Hash{0x1},
+			Number:     big.NewInt(10),
+			Difficulty: big.NewInt(7),
+			Coinbase:   coinbase,
+			Time:       time,
+			Extra:      make([]byte, crypto.SignatureLength),
+		}
+	}
+
+	header1 := newHeader(common.Address{0x1}, 1)
+	header2 := newHeader(common.Address{0x2}, 2)
+	header3 := newHeader(common.Address{0x1}, 3)
+
+	monitor.CheckDoubleSign(header1)
+	monitor.CheckDoubleSign(header1)
+	monitor.CheckDoubleSign(header2)
+	if evidences := rawdb.ReadDoubleSignEvidences(db, 0, 100); len(evidences) != 0 {
+		t.Fatalf("Expect no evidence, got %d", len(evidences))
+	}
+
+	monitor.CheckDoubleSign(header3)
+	evidences := rawdb.ReadDoubleSignEvidences(db, 0, 100)
+	if len(evidences) != 1 {
+		t.Fatalf("Expect 1 evidence, got %d", len(evidences))
+	}
+	if evidences[0].Signer != header1.Coinbase {
+		t.Fatalf("Expect signer %s, got %s", header1.Coinbase, evidences[0].Signer)
+	}
+	if evidences[0].Header1.Hash() != header1.Hash() || evidences[0].Header2.Hash() != header3.Hash() {
+		t.Fatalf("Mismatched headers in evidence")
+	}
+
+	if evidences := rawdb.ReadDoubleSignEvidences(db, 11, 100); len(evidences) != 0 {
+		t.Fatalf("Expect no evidence out of range, got %d", len(evidences))
+	}
+}
@@ -0,0 +1,39 @@
+package monitor
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// fileAlerter appends the alerts to a local file, one JSON object per line.
+type fileAlerter struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+func newFileAlerter(path string) (*fileAlerter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileAlerter{file: file}, nil
+}
+
+func (alerter *fileAlerter) Alert(header, body string) {
+	message, _ := json.Marshal(webhookMessage{
+		Header:    header,
+		Body:      body,
+		Timestamp: time.Now().Unix(),
+	})
+
+	alerter.lock.Lock()
+	defer alerter.lock.Unlock()
+
+	if _, err := alerter.file.Write(append(message, '\n')); err != nil {
+		log.Error("Failed to write alert to file", "file", alerter.file.Name(), "err", err)
+	}
+}
@@ -26,12 +26,18 @@ type FinalityVoteMonitor struct {
 	chain         consensus.ChainHeaderReader
 	engine        consensus.FastFinalityPoSA
 	observedVotes *lru.Cache
-	alerter       *slackAlerter
+	alerter       Alerter
+	submitter     *SlashingSubmitter
 }
 
+// NewFinalityVoteMonitor creates a finality vote monitor. The alerter and
+// submitter are optional, if provided, the violation is sent to the alerter and
+// the finality vote proof is submitted to SlashIndicator contract.
 func NewFinalityVoteMonitor(
 	chain consensus.ChainHeaderReader,
 	engine consensus.FastFinalityPoSA,
+	alerter Alerter,
+	submitter *SlashingSubmitter,
 ) (*FinalityVoteMonitor, error) {
 	observedVotes, err := lru.New(finalityVoteCache)
 	if err != nil {
@@ -39,9 +45,11 @@ func NewFinalityVoteMonitor(
 	}
 
 	return &FinalityVoteMonitor{
+		chain:         chain,
 		engine:        engine,
 		observedVotes: observedVotes,
-		alerter:       NewSlackAlert(),
+		alerter:       alerter,
+		submitter:     submitter,
 	}, nil
 }
 
@@ -124,14 +132,22 @@ func (monitor *FinalityVoteMonitor) checkSameHeightVote(
 	violated := false
 	blockInfo := rawBlockInfo.([]blockInformation)
 
-	for _, block := range blockInfo {
+	newBlock := blockInformation{
+		blockHash:           blockHash,
+		voterPublicKey:      voterPublicKey,
+		voterAddress:        voterAddress,
+		aggregatedSignature: aggregatedSignature,
+	}
+
+	for i := range blockInfo {
+		block := &blockInfo[i]
 		// 2 blocks are the same, it's not likely to happen
 		if block.blockHash == blockHash {
 			continue
 		}
 
 		for _, cachePublicKey := range block.voterPublicKey {
-			for _, blockPublicKey := range voterPublicKey {
+			for j, blockPublicKey := range voterPublicKey {
 				if blockPublicKey.Equals(cachePublicKey) {
 					alertHeader := "Fast finality rule is violated"
 					alertFormat := "- Voter public key: %s\n" +
@@ -165,17 +181,13 @@ func (monitor *FinalityVoteMonitor) checkSameHeightVote(
 					log.Error(alertHeader, "message", alertBody)
 
 					violated = true
+					monitor.submitProof(newFinalityVoteProof(blockPublicKey, voterAddress[j], blockNumber, block, &newBlock))
 				}
 			}
 		}
 	}
 
-	blockInfo = append(blockInfo, blockInformation{
-		blockHash:           blockHash,
-		voterPublicKey:      voterPublicKey,
-		voterAddress:        voterAddress,
-		aggregatedSignature: aggregatedSignature,
-	})
+	blockInfo = append(blockInfo, newBlock)
 
 	monitor.observedVotes.Add(blockNumber, blockInfo)
 
@@ -184,3 +196,16 @@ func (monitor *FinalityVoteMonitor) checkSameHeightVote(
 	}
 	return nil
 }
+
+func (monitor *FinalityVoteMonitor) submitProof(proof *FinalityVoteProof) {
+	if monitor.submitter == nil {
+		return
+	}
+
+	if err := proof.Verify(); err != nil {
+		log.Error("Invalid finality vote proof, skip submitting", "voter", proof.VoterAddress,
+			"block number", proof.TargetBlockNumber, "err", err)
+		return
+	}
+	monitor.submitter.SubmitFinalityVoteProof(proof)
+}
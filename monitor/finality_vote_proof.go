@@ -0,0 +1,130 @@
+package monitor
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	slashIndicator "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/slash_indicator"
+	"github.com/ethereum/go-ethereum/core/types"
+	blsCommon "github.com/ethereum/go-ethereum/crypto/bls/common"
+)
+
+// maxBlsPublicKeyListLength is the maximum number of public keys in each list of
+// the proof, it must be kept the same as the one in validateFinalityVoteProof precompile
+const maxBlsPublicKeyListLength = 100
+
+var (
+	errSameTargetHash        = errors.New("block hash is the same")
+	errVoterNotInList        = errors.New("reported voter does not in public key list")
+	errPublicKeyListTooLong  = errors.New("public key list is too long")
+	errInvalidProofSignature = errors.New("failed to verify signature")
+)
+
+// FinalityVoteProof is the evidence that a voter has voted for 2 different
+// blocks at the same height. It has the same shape as the input of
+// validateFinalityVoteProof precompile.
+type FinalityVoteProof struct {
+	VoterPublicKey      blsCommon.PublicKey
+	VoterAddress        common.Address
+	TargetBlockNumber   uint64
+	TargetBlockHash     [2]common.Hash
+	ListOfPublicKey     [2][]blsCommon.PublicKey
+	AggregatedSignature [2]blsCommon.Signature
+}
+
+func newFinalityVoteProof(
+	voterPublicKey blsCommon.PublicKey,
+	voterAddress common.Address,
+	blockNumber uint64,
+	block1 *blockInformation,
+	block2 *blockInformation,
+) *FinalityVoteProof {
+	return &FinalityVoteProof{
+		VoterPublicKey:      voterPublicKey,
+		VoterAddress:        voterAddress,
+		TargetBlockNumber:   blockNumber,
+		TargetBlockHash:     [2]common.Hash{block1.blockHash, block2.blockHash},
+		ListOfPublicKey:     [2][]blsCommon.PublicKey{block1.voterPublicKey, block2.voterPublicKey},
+		AggregatedSignature: [2]blsCommon.Signature{block1.aggregatedSignature, block2.aggregatedSignature},
+	}
+}
+
+// Verify checks the proof with the same rules as validateFinalityVoteProof
+// precompile, so that we don't submit the proof that is rejected by the contract.
+func (proof *FinalityVoteProof) Verify() error {
+	if proof.TargetBlockHash[0] == proof.TargetBlockHash[1] {
+		return errSameTargetHash
+	}
+
+	for block := 0; block < 2; block++ {
+		if len(proof.ListOfPublicKey[block]) > maxBlsPublicKeyListLength {
+			return errPublicKeyListTooLong
+		}
+
+		voterInPublicKeyList := false
+		for _, publicKey := range proof.ListOfPublicKey[block] {
+			if publicKey.Equals(proof.VoterPublicKey) {
+				voterInPublicKeyList = true
+				break
+			}
+		}
+		if !voterInPublicKeyList {
+			return errVoterNotInList
+		}
+
+		voteData := types.VoteData{
+			TargetNumber: proof.TargetBlockNumber,
+			TargetHash:   proof.TargetBlockHash[block],
+		}
+		if !proof.AggregatedSignature[block].FastAggregateVerify(proof.ListOfPublicKey[block], voteData.Hash()) {
+			return errInvalidProofSignature
+		}
+	}
+
+	return nil
+}
+
+// Key returns the identifier of the proof, it is used to avoid submitting the
+// same offense multiple times.
+func (proof *FinalityVoteProof) Key() string {
+	return common.Bytes2Hex(proof.VoterPublicKey.Marshal()) + "-" + new(big.Int).SetUint64(proof.TargetBlockNumber).String()
+}
+
+// Offender returns the consensus address of the voter.
+func (proof *FinalityVoteProof) Offender() common.Address {
+	return proof.VoterAddress
+}
+
+// Number returns the target block number of the votes.
+func (proof *FinalityVoteProof) Number() uint64 {
+	return proof.TargetBlockNumber
+}
+
+// slashingTx creates the transaction calling slashFastFinality method.
+func (proof *FinalityVoteProof) slashingTx(contract *slashIndicator.SlashIndicatorTransactor, opts *bind.TransactOpts) (*types.Transaction, error) {
+	var (
+		targetBlockHash     [2][32]byte
+		listOfPublicKey     [2][][]byte
+		aggregatedSignature [2][]byte
+	)
+
+	for block := 0; block < 2; block++ {
+		targetBlockHash[block] = proof.TargetBlockHash[block]
+		for _, publicKey := range proof.ListOfPublicKey[block] {
+			listOfPublicKey[block] = append(listOfPublicKey[block], publicKey.Marshal())
+		}
+		aggregatedSignature[block] = proof.AggregatedSignature[block].Marshal()
+	}
+
+	return contract.SlashFastFinality(
+		opts,
+		proof.VoterAddress,
+		proof.VoterPublicKey.Marshal(),
+		new(big.Int).SetUint64(proof.TargetBlockNumber),
+		targetBlockHash,
+		listOfPublicKey,
+		aggregatedSignature,
+	)
+}
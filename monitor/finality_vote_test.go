@@ -1,15 +1,20 @@
 package monitor
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	slashIndicator "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/slash_indicator"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto/bls/blst"
 	blsCommon "github.com/ethereum/go-ethereum/crypto/bls/common"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 func TestCheckSameHeightVote(t *testing.T) {
-	monitor, err := NewFinalityVoteMonitor(nil, nil)
+	monitor, err := NewFinalityVoteMonitor(nil, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Failed to create finality vote monitor, err %s", err)
 	}
@@ -45,3 +50,138 @@ func TestCheckSameHeightVote(t *testing.T) {
 		t.Fatalf("Expect error when checkSameHeightVote")
 	}
 }
+
+type mockTxPool struct {
+	txs []*types.Transaction
+}
+
+func (pool *mockTxPool) Nonce(addr common.Address) uint64 { return uint64(len(pool.txs)) }
+
+func (pool *mockTxPool) GasPrice() *big.Int { return big.NewInt(1) }
+
+func (pool *mockTxPool) AddLocal(tx *types.Transaction) error {
+	pool.txs = append(pool.txs, tx)
+	return nil
+}
+
+func TestSubmitFinalityVoteProof(t *testing.T) {
+	chainConfig := &params.ChainConfig{
+		ChainID: big.NewInt(2021),
+		ConsortiumV2Contracts: &params.ConsortiumV2Contracts{
+			SlashIndicator: common.Address{0x10},
+		},
+	}
+	pool := &mockTxPool{}
+	submitter, err := NewSlashingSubmitter(chainConfig, pool)
+	if err != nil {
+		t.Fatalf("Failed to create slashing submitter, err %s", err)
+	}
+	signer := common.Address{0x11}
+	submitter.Authorize(signer, func(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+		if account.Address != signer {
+			t.Fatalf("Signer mismatches, expect %s got %s", signer, account.Address)
+		}
+		return tx, nil
+	})
+
+	monitor, err := NewFinalityVoteMonitor(nil, nil, nil, submitter)
+	if err != nil {
+		t.Fatalf("Failed to create finality vote monitor, err %s", err)
+	}
+
+	key, err := blst.RandKey()
+	if err != nil {
+		t.Fatalf("Failed to create bls key, err %s", err)
+	}
+	voterPublicKey := []blsCommon.PublicKey{key.PublicKey()}
+	voterAddress := []common.Address{{0x1}}
+
+	const blockNumber = 10
+	sign := func(hash common.Hash) blsCommon.Signature {
+		voteData := types.VoteData{TargetNumber: blockNumber, TargetHash: hash}
+		digest := voteData.Hash()
+		return key.Sign(digest[:])
+	}
+
+	if err := monitor.checkSameHeightVote(blockNumber, common.Hash{0x1}, voterPublicKey, voterAddress, sign(common.Hash{0x1})); err != nil {
+		t.Fatalf("Expect no error when checkSameHeightVote, got %s", err)
+	}
+	if err := monitor.checkSameHeightVote(blockNumber, common.Hash{0x2}, voterPublicKey, voterAddress, sign(common.Hash{0x2})); err == nil {
+		t.Fatalf("Expect error when checkSameHeightVote")
+	}
+	// The same violation must not be queued twice
+	if err := monitor.checkSameHeightVote(blockNumber, common.Hash{0x2}, voterPublicKey, voterAddress, sign(common.Hash{0x2})); err == nil {
+		t.Fatalf("Expect error when checkSameHeightVote")
+	}
+	if len(submitter.proofCh) != 1 {
+		t.Fatalf("Expect 1 queued proof, got %d", len(submitter.proofCh))
+	}
+
+	proof := <-submitter.proofCh
+	tx, err := submitter.sendProof(proof)
+	if err != nil {
+		t.Fatalf("Failed to send finality vote proof, err %s", err)
+	}
+	if *tx.To() != chainConfig.ConsortiumV2Contracts.SlashIndicator {
+		t.Fatalf("Expect slashing transaction to %s, got %s", chainConfig.ConsortiumV2Contracts.SlashIndicator, tx.To())
+	}
+
+	slashIndicatorAbi, err := slashIndicator.SlashIndicatorMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("Failed to parse slash indicator ABI, err %s", err)
+	}
+	method, err := slashIndicatorAbi.MethodById(tx.Data())
+	if err != nil {
+		t.Fatalf("Failed to get method from transaction data, err %s", err)
+	}
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		t.Fatalf("Failed to unpack transaction data, err %s", err)
+	}
+	if method.Name != "slashFastFinality" {
+		t.Fatalf("Expect slashFastFinality method, got %s", method.Name)
+	}
+	if args[0].(common.Address) != voterAddress[0] {
+		t.Fatalf("Expect consensus address %s, got %s", voterAddress[0], args[0])
+	}
+	if len(pool.txs) != 1 {
+		t.Fatalf("Expect 1 transaction in pool, got %d", len(pool.txs))
+	}
+}
+
+func TestVerifyFinalityVoteProof(t *testing.T) {
+	key, err := blst.RandKey()
+	if err != nil {
+		t.Fatalf("Failed to create bls key, err %s", err)
+	}
+
+	const blockNumber = 10
+	var signatures [2]blsCommon.Signature
+	hashes := [2]common.Hash{{0x1}, {0x2}}
+	for i, hash := range hashes {
+		voteData := types.VoteData{TargetNumber: blockNumber, TargetHash: hash}
+		digest := voteData.Hash()
+		signatures[i] = key.Sign(digest[:])
+	}
+
+	proof := FinalityVoteProof{
+		VoterPublicKey:      key.PublicKey(),
+		TargetBlockNumber:   blockNumber,
+		TargetBlockHash:     hashes,
+		ListOfPublicKey:     [2][]blsCommon.PublicKey{{key.PublicKey()}, {key.PublicKey()}},
+		AggregatedSignature: signatures,
+	}
+	if err := proof.Verify(); err != nil {
+		t.Fatalf("Expect valid proof, got %s", err)
+	}
+
+	proof.AggregatedSignature = [2]blsCommon.Signature{signatures[1], signatures[0]}
+	if err := proof.Verify(); err != errInvalidProofSignature {
+		t.Fatalf("Expect %s, got %v", errInvalidProofSignature, err)
+	}
+
+	proof.TargetBlockHash = [2]common.Hash{hashes[0], hashes[0]}
+	if err := proof.Verify(); err != errSameTargetHash {
+		t.Fatalf("Expect %s, got %v", errSameTargetHash, err)
+	}
+}
@@ -0,0 +1,37 @@
+package monitor
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var alertCounter = metrics.NewRegisteredCounter("monitor/alerts", nil)
+
+// metricsAlerter counts the alerts in the metrics registry, the counters are
+// exposed through the metrics endpoints, e.g. Prometheus.
+type metricsAlerter struct{}
+
+func newMetricsAlerter() *metricsAlerter {
+	return &metricsAlerter{}
+}
+
+func (alerter *metricsAlerter) Alert(header, body string) {
+	alertCounter.Inc(1)
+	metrics.GetOrRegisterCounter("monitor/alerts/"+metricName(header), nil).Inc(1)
+}
+
+// metricName converts the alert header to a metric name, e.g.
+// "Double sign detected" -> "double_sign_detected"
+func metricName(header string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '_'
+		}
+	}, header)
+}
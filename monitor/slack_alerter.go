@@ -4,13 +4,19 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
-	"os"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 )
 
-const reponseBuffer = 2048
+const (
+	reponseBuffer = 2048
+
+	// alertRequestTimeout is the time limit of the requests to the alert
+	// sinks, so an unresponsive sink doesn't hold up the other alerts.
+	alertRequestTimeout = 10 * time.Second
+)
 
 type slackAlerter struct {
 	url    string
@@ -48,19 +54,24 @@ func formatMessage(header, body string) string {
 }
 
 func (alerter *slackAlerter) Alert(header, body string) {
-	message := formatMessage(header, body)
+	postMessage(alerter.client, alerter.url, formatMessage(header, body))
+}
 
-	request, err := http.NewRequest("POST", alerter.url, strings.NewReader(message))
+// postMessage sends the JSON message to url and logs the error if any
+func postMessage(client *http.Client, url string, message string) {
+	request, err := http.NewRequest("POST", url, strings.NewReader(message))
 	if err != nil {
-		log.Error("Failed to send Slack alert", "err", err)
+		log.Error("Failed to create alert request", "err", err)
 		return
 	}
+	request.Header.Set("Content-Type", "application/json")
 
-	response, err := alerter.client.Do(request)
+	response, err := client.Do(request)
 	if err != nil {
 		log.Error("Failed to send HTTP request", "err", err)
 		return
 	}
+	defer response.Body.Close()
 
 	if response.StatusCode >= 400 {
 		responseBody := make([]byte, reponseBuffer)
@@ -70,16 +81,18 @@ func (alerter *slackAlerter) Alert(header, body string) {
 	}
 }
 
-func NewSlackAlert() *slackAlerter {
-	slackUrl := os.Getenv("SLACK_WEBHOOK_URL")
-	client := &http.Client{
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: alertRequestTimeout,
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			return errors.New("invalid redirect")
 		},
 	}
+}
 
+func NewSlackAlert(url string) *slackAlerter {
 	return &slackAlerter{
-		url:    slackUrl,
-		client: client,
+		url:    url,
+		client: newHTTPClient(),
 	}
 }
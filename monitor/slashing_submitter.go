@@ -0,0 +1,218 @@
+package monitor
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	slashIndicator "github.com/ethereum/go-ethereum/consensus/consortium/generated_contracts/slash_indicator"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// slashingGasLimit is the gas limit of the slashing transaction, the
+	// proof verification in precompiles is the most expensive part.
+	slashingGasLimit = 3_000_000
+	// submittedProofCache is the number of submitted proofs kept to avoid
+	// submitting the same proof twice.
+	submittedProofCache = 1024
+	proofQueueSize      = 64
+	maxSubmitAttempts   = 5
+	submitRetryInterval = 3 * time.Second
+)
+
+var (
+	errSubmitterNotAuthorized = errors.New("slashing submitter is not authorized")
+	errNoSlashIndicator       = errors.New("slash indicator contract is not configured")
+)
+
+// slashingProof is the malicious proof that can be submitted to SlashIndicator
+// contract.
+type slashingProof interface {
+	// Key returns the identifier of the offense
+	Key() string
+	// Offender returns the consensus address of the slashed validator
+	Offender() common.Address
+	// Number returns the block number where the offense happens
+	Number() uint64
+	// slashingTx creates the slashing transaction with the contract binding
+	slashingTx(contract *slashIndicator.SlashIndicatorTransactor, opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// TxPool is the subset of transaction pool methods used to submit the slashing
+// transactions.
+type TxPool interface {
+	Nonce(addr common.Address) uint64
+	GasPrice() *big.Int
+	AddLocal(tx *types.Transaction) error
+}
+
+// SignerTxFn is a signer callback function to request a wallet to sign the
+// given transaction.
+type SignerTxFn func(accounts.Account, *types.Transaction, *big.Int) (*types.Transaction, error)
+
+// SlashingSubmitter turns the malicious proofs found by monitors into slashing
+// transactions to SlashIndicator contract and sends them to the transaction pool.
+type SlashingSubmitter struct {
+	chainConfig    *params.ChainConfig
+	txPool         TxPool
+	slashIndicator *slashIndicator.SlashIndicatorTransactor // nil if the contract is not configured
+
+	lock     sync.RWMutex
+	signer   common.Address
+	signTxFn SignerTxFn
+
+	submitted *lru.Cache
+	proofCh   chan slashingProof
+	quit      chan struct{}
+	wg        sync.WaitGroup
+}
+
+func NewSlashingSubmitter(chainConfig *params.ChainConfig, txPool TxPool) (*SlashingSubmitter, error) {
+	submitted, err := lru.New(submittedProofCache)
+	if err != nil {
+		return nil, err
+	}
+
+	// The slashing transactions are only created by the contract binding, they
+	// are added to the transaction pool directly so no contract backend is needed
+	var contract *slashIndicator.SlashIndicatorTransactor
+	if chainConfig.ConsortiumV2Contracts != nil {
+		contract, err = slashIndicator.NewSlashIndicatorTransactor(chainConfig.ConsortiumV2Contracts.SlashIndicator, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &SlashingSubmitter{
+		chainConfig:    chainConfig,
+		txPool:         txPool,
+		slashIndicator: contract,
+		submitted:      submitted,
+		proofCh:        make(chan slashingProof, proofQueueSize),
+		quit:           make(chan struct{}),
+	}, nil
+}
+
+// Authorize sets the account which signs the slashing transactions.
+func (submitter *SlashingSubmitter) Authorize(signer common.Address, signTxFn SignerTxFn) {
+	submitter.lock.Lock()
+	defer submitter.lock.Unlock()
+
+	submitter.signer = signer
+	submitter.signTxFn = signTxFn
+}
+
+func (submitter *SlashingSubmitter) Start() {
+	submitter.wg.Add(1)
+	go submitter.loop()
+}
+
+func (submitter *SlashingSubmitter) Stop() {
+	close(submitter.quit)
+	submitter.wg.Wait()
+}
+
+// SubmitFinalityVoteProof queues the finality vote proof for submission. The
+// proof that has already been submitted is ignored.
+func (submitter *SlashingSubmitter) SubmitFinalityVoteProof(proof *FinalityVoteProof) {
+	submitter.submit(proof)
+}
+
+// SubmitDoubleSignProof queues the double sign proof for submission. The proof
+// that has already been submitted is ignored.
+func (submitter *SlashingSubmitter) SubmitDoubleSignProof(proof *DoubleSignProof) {
+	submitter.submit(proof)
+}
+
+func (submitter *SlashingSubmitter) submit(proof slashingProof) {
+	key := proof.Key()
+	if ok, _ := submitter.submitted.ContainsOrAdd(key, struct{}{}); ok {
+		log.Debug("Slashing proof is already submitted", "key", key)
+		return
+	}
+
+	select {
+	case submitter.proofCh <- proof:
+	default:
+		log.Warn("Slashing proof queue is full, drop proof", "key", key)
+		submitter.submitted.Remove(key)
+	}
+}
+
+func (submitter *SlashingSubmitter) loop() {
+	defer submitter.wg.Done()
+
+	for {
+		select {
+		case proof := <-submitter.proofCh:
+			submitter.submitWithRetry(proof)
+		case <-submitter.quit:
+			return
+		}
+	}
+}
+
+func (submitter *SlashingSubmitter) submitWithRetry(proof slashingProof) {
+	var err error
+	for attempt := 1; attempt <= maxSubmitAttempts; attempt++ {
+		var tx *types.Transaction
+		if tx, err = submitter.sendProof(proof); err == nil {
+			log.Info("Submitted slashing transaction", "offender", proof.Offender(),
+				"block number", proof.Number(), "tx", tx.Hash())
+			return
+		}
+		log.Warn("Failed to submit slashing transaction", "offender", proof.Offender(),
+			"block number", proof.Number(), "attempt", attempt, "err", err)
+
+		select {
+		case <-time.After(submitRetryInterval):
+		case <-submitter.quit:
+			return
+		}
+	}
+
+	// Allow the proof to be submitted again if it is detected later
+	submitter.submitted.Remove(proof.Key())
+	log.Error("Give up submitting slashing transaction", "offender", proof.Offender(),
+		"block number", proof.Number(), "err", err)
+}
+
+func (submitter *SlashingSubmitter) sendProof(proof slashingProof) (*types.Transaction, error) {
+	submitter.lock.RLock()
+	signer, signTxFn := submitter.signer, submitter.signTxFn
+	submitter.lock.RUnlock()
+
+	if signTxFn == nil {
+		return nil, errSubmitterNotAuthorized
+	}
+	if submitter.slashIndicator == nil {
+		return nil, errNoSlashIndicator
+	}
+
+	signedTx, err := proof.slashingTx(submitter.slashIndicator, &bind.TransactOpts{
+		From:     signer,
+		Nonce:    new(big.Int).SetUint64(submitter.txPool.Nonce(signer)),
+		GasPrice: submitter.txPool.GasPrice(),
+		GasLimit: slashingGasLimit,
+		NoSend:   true,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return signTxFn(accounts.Account{Address: address}, tx, submitter.chainConfig.ChainID)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := submitter.txPool.AddLocal(signedTx); err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
@@ -0,0 +1,31 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package monitor
+
+import (
+	"log/syslog"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const syslogTag = "ronin-monitor"
+
+// syslogAlerter writes the alerts to the local syslog daemon.
+type syslogAlerter struct {
+	writer *syslog.Writer
+}
+
+func newSyslogAlerter() (Alerter, error) {
+	writer, err := syslog.New(syslog.LOG_CRIT|syslog.LOG_DAEMON, syslogTag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogAlerter{writer: writer}, nil
+}
+
+func (alerter *syslogAlerter) Alert(header, body string) {
+	if err := alerter.writer.Crit(header + "\n" + body); err != nil {
+		log.Error("Failed to write alert to syslog", "err", err)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package monitor
+
+import "errors"
+
+func newSyslogAlerter() (Alerter, error) {
+	return nil, errors.New("syslog is not supported on this platform")
+}
@@ -0,0 +1,157 @@
+package monitor
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/consortium/v2/finality"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// ValidatorStats is the block production and finality voting statistics of
+// a validator in a range of blocks.
+type ValidatorStats struct {
+	Address         common.Address `json:"address"`
+	InTurnSlots     uint64         `json:"inTurnSlots"`     // Number of blocks that the validator is in-turn
+	InTurnSealed    uint64         `json:"inTurnSealed"`    // Number of in-turn blocks sealed by the validator
+	MissedInTurn    uint64         `json:"missedInTurn"`    // Number of in-turn blocks sealed by other validators
+	OutOfTurnSealed uint64         `json:"outOfTurnSealed"` // Number of out-of-turn blocks sealed by the validator
+	FinalityVotes   uint64         `json:"finalityVotes"`   // Number of blocks that include the validator's finality vote
+}
+
+// ValidatorStatsCollector aggregates the statistics of validators over the
+// recorded blocks.
+type ValidatorStatsCollector struct {
+	stats map[common.Address]*ValidatorStats
+	order []common.Address
+}
+
+func NewValidatorStatsCollector() *ValidatorStatsCollector {
+	return &ValidatorStatsCollector{
+		stats: make(map[common.Address]*ValidatorStats),
+	}
+}
+
+func (collector *ValidatorStatsCollector) get(address common.Address) *ValidatorStats {
+	stats, ok := collector.stats[address]
+	if !ok {
+		stats = &ValidatorStats{Address: address}
+		collector.stats[address] = stats
+		collector.order = append(collector.order, address)
+	}
+	return stats
+}
+
+// Record adds the block sealed by sealer to the statistics. The validators are
+// the sorted validator set at the parent block, the in-turn validator is
+// determined the same way as the consortium v2 snapshot does. The voters are the
+// validators included in the block's FinalityVotedValidators.
+func (collector *ValidatorStatsCollector) Record(
+	blockNumber uint64,
+	validators []common.Address,
+	sealer common.Address,
+	voters []common.Address,
+) {
+	if len(validators) == 0 {
+		return
+	}
+
+	for _, validator := range validators {
+		collector.get(validator)
+	}
+
+	inTurn := validators[blockNumber%uint64(len(validators))]
+	collector.get(inTurn).InTurnSlots++
+	if sealer == inTurn {
+		collector.get(sealer).InTurnSealed++
+	} else {
+		collector.get(inTurn).MissedInTurn++
+		collector.get(sealer).OutOfTurnSealed++
+	}
+
+	for _, voter := range voters {
+		collector.get(voter).FinalityVotes++
+	}
+}
+
+// Stats returns the statistics of all validators seen in the recorded blocks.
+func (collector *ValidatorStatsCollector) Stats() []*ValidatorStats {
+	result := make([]*ValidatorStats, 0, len(collector.order))
+	for _, address := range collector.order {
+		result = append(result, collector.stats[address])
+	}
+	return result
+}
+
+// ValidatorLivenessMonitor tracks the block production and finality voting of
+// validators in new blocks and exposes them as metrics.
+type ValidatorLivenessMonitor struct {
+	chain  consensus.ChainHeaderReader
+	engine consensus.FastFinalityPoSA
+}
+
+func NewValidatorLivenessMonitor(
+	chain consensus.ChainHeaderReader,
+	engine consensus.FastFinalityPoSA,
+) *ValidatorLivenessMonitor {
+	return &ValidatorLivenessMonitor{
+		chain:  chain,
+		engine: engine,
+	}
+}
+
+// CheckBlock records the block in the validator metrics, the block must be
+// after Shillin.
+func (monitor *ValidatorLivenessMonitor) CheckBlock(block *types.Block) error {
+	if block.NumberU64() == 0 {
+		return nil
+	}
+	blockValidator := monitor.engine.GetActiveValidatorAt(
+		monitor.chain,
+		block.NumberU64()-1,
+		block.ParentHash(),
+	)
+	if len(blockValidator) == 0 {
+		return nil
+	}
+
+	extraData, err := finality.DecodeExtra(block.Extra(), true)
+	// This should not happen because the block has been verified
+	if err != nil {
+		log.Error("Unexpected error when decode extradata", "err", err)
+		return err
+	}
+
+	validators := make([]common.Address, len(blockValidator))
+	for i, validator := range blockValidator {
+		validators[i] = validator.Address
+	}
+
+	var voters []common.Address
+	if extraData.HasFinalityVote == 1 {
+		for _, pos := range extraData.FinalityVotedValidators.Indices() {
+			if pos < len(validators) {
+				voters = append(voters, validators[pos])
+			}
+		}
+	}
+
+	collector := NewValidatorStatsCollector()
+	collector.Record(block.NumberU64(), validators, block.Coinbase(), voters)
+	for _, stats := range collector.Stats() {
+		updateValidatorMetrics(stats)
+	}
+	return nil
+}
+
+func updateValidatorMetrics(stats *ValidatorStats) {
+	prefix := fmt.Sprintf("monitor/validator/%s/", stats.Address.Hex())
+	metrics.GetOrRegisterCounter(prefix+"inturn", nil).Inc(int64(stats.InTurnSlots))
+	metrics.GetOrRegisterCounter(prefix+"sealed", nil).Inc(int64(stats.InTurnSealed))
+	metrics.GetOrRegisterCounter(prefix+"missed", nil).Inc(int64(stats.MissedInTurn))
+	metrics.GetOrRegisterCounter(prefix+"outofturn", nil).Inc(int64(stats.OutOfTurnSealed))
+	metrics.GetOrRegisterCounter(prefix+"finalityvotes", nil).Inc(int64(stats.FinalityVotes))
+}
@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestValidatorStatsCollector(t *testing.T) {
+	validator1, validator2, validator3 := common.Address{0x1}, common.Address{0x2}, common.Address{0x3}
+	validators := []common.Address{validator1, validator2, validator3}
+
+	collector := NewValidatorStatsCollector()
+	// Block 3 is in-turn of validator1 and sealed by validator1
+	collector.Record(3, validators, validator1, []common.Address{validator1, validator2})
+	// Block 4 is in-turn of validator2 but sealed by validator3
+	collector.Record(4, validators, validator3, []common.Address{validator1})
+	// Block 5 is in-turn of validator3 and sealed by validator3
+	collector.Record(5, validators, validator3, nil)
+
+	expected := []ValidatorStats{
+		{Address: validator1, InTurnSlots: 1, InTurnSealed: 1, FinalityVotes: 2},
+		{Address: validator2, InTurnSlots: 1, MissedInTurn: 1, FinalityVotes: 1},
+		{Address: validator3, InTurnSlots: 1, InTurnSealed: 1, OutOfTurnSealed: 1},
+	}
+	stats := collector.Stats()
+	if len(stats) != len(expected) {
+		t.Fatalf("Expect %d validator stats, got %d", len(expected), len(stats))
+	}
+	for i := range expected {
+		if *stats[i] != expected[i] {
+			t.Fatalf("Mismatched stats of validator %d, expect %+v got %+v", i, expected[i], *stats[i])
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package monitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+)
+
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// webhookAlerter posts the alert as a JSON object to a generic webhook.
+type webhookAlerter struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookAlerter(url string) *webhookAlerter {
+	return &webhookAlerter{
+		url:    url,
+		client: newHTTPClient(),
+	}
+}
+
+type webhookMessage struct {
+	Header    string `json:"header"`
+	Body      string `json:"body"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func (alerter *webhookAlerter) Alert(header, body string) {
+	message, _ := json.Marshal(webhookMessage{
+		Header:    header,
+		Body:      body,
+		Timestamp: time.Now().Unix(),
+	})
+	postMessage(alerter.client, alerter.url, string(message))
+}
+
+// pagerDutyAlerter triggers a PagerDuty incident through Events API v2.
+type pagerDutyAlerter struct {
+	url        string
+	routingKey string
+	source     string
+	client     *http.Client
+}
+
+func newPagerDutyAlerter(routingKey string) *pagerDutyAlerter {
+	source, err := os.Hostname()
+	if err != nil {
+		source = "ronin"
+	}
+
+	return &pagerDutyAlerter{
+		url:        pagerDutyEventsURL,
+		routingKey: routingKey,
+		source:     source,
+		client:     newHTTPClient(),
+	}
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Timestamp     string            `json:"timestamp"`
+	CustomDetails map[string]string `json:"custom_details"`
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+func (alerter *pagerDutyAlerter) Alert(header, body string) {
+	message, _ := json.Marshal(pagerDutyEvent{
+		RoutingKey:  alerter.routingKey,
+		EventAction: "trigger",
+		// Let PagerDuty group the repeated alerts into the same incident
+		DedupKey: alertKey(header, body),
+		Payload: pagerDutyPayload{
+			Summary:   header,
+			Source:    alerter.source,
+			Severity:  "critical",
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			CustomDetails: map[string]string{
+				"body": body,
+			},
+		},
+	})
+	postMessage(alerter.client, alerter.url, string(message))
+}
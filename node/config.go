@@ -25,6 +25,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -204,9 +205,16 @@ type Config struct {
 	MaxCurVoteAmountPerBlock int
 	EnableFastFinality       bool
 	EnableFastFinalitySign   bool
+	// The journal of finality votes to survive node restarts, disabled if empty
+	VoteJournal string
+	// Time interval to regenerate the finality vote journal
+	VoteRejournal time.Duration
 	// The path of password and encrypted BLS secret key used for fast finality voting
 	BlsPasswordPath string
 	BlsWalletPath   string
+	// The endpoint of the external BLS signer, it is used instead of the local
+	// BLS wallet if provided
+	BlsRemoteSigner string
 
 	DBEngine string `toml:",omitempty"`
 }
@@ -87,6 +87,12 @@ type SendTxArgs struct {
 	// For non-legacy transactions
 	AccessList *types.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big      `json:"chainId,omitempty"`
+
+	// For sponsored transactions
+	ExpiredTime *hexutil.Uint64 `json:"expiredTime,omitempty"`
+	PayerV      *hexutil.Big    `json:"payerV,omitempty"`
+	PayerR      *hexutil.Big    `json:"payerR,omitempty"`
+	PayerS      *hexutil.Big    `json:"payerS,omitempty"`
 }
 
 func (args SendTxArgs) String() string {
@@ -115,6 +121,25 @@ func (args *SendTxArgs) ToTransaction() *types.Transaction {
 
 	var data types.TxData
 	switch {
+	case args.ExpiredTime != nil:
+		gasFeeCap, gasTipCap := (*big.Int)(args.MaxFeePerGas), (*big.Int)(args.MaxPriorityFeePerGas)
+		if args.GasPrice != nil {
+			gasFeeCap, gasTipCap = (*big.Int)(args.GasPrice), (*big.Int)(args.GasPrice)
+		}
+		data = &types.SponsoredTx{
+			To:          to,
+			ChainID:     (*big.Int)(args.ChainID),
+			Nonce:       uint64(args.Nonce),
+			Gas:         uint64(args.Gas),
+			GasFeeCap:   gasFeeCap,
+			GasTipCap:   gasTipCap,
+			Value:       (*big.Int)(&args.Value),
+			Data:        input,
+			ExpiredTime: uint64(*args.ExpiredTime),
+			PayerV:      (*big.Int)(args.PayerV),
+			PayerR:      (*big.Int)(args.PayerR),
+			PayerS:      (*big.Int)(args.PayerS),
+		}
 	case args.MaxFeePerGas != nil:
 		al := types.AccessList{}
 		if args.AccessList != nil {
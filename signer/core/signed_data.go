@@ -64,8 +64,27 @@ var (
 		accounts.MimetypeTextPlain,
 		0x45,
 	}
+	SponsoredTxPayer = SigFormat{
+		accounts.MimetypeSponsoredTxPayer,
+		types.SponsoredTxType,
+	}
 )
 
+// SponsoredTxPayerData is the part of a sponsored transaction that is signed
+// by the payer, see types.PayerSigningData.
+type SponsoredTxPayerData struct {
+	ChainID     *big.Int
+	Sender      common.Address
+	Nonce       uint64
+	GasTipCap   *big.Int
+	GasFeeCap   *big.Int
+	Gas         uint64
+	To          *common.Address `rlp:"nil"`
+	Value       *big.Int
+	Data        []byte
+	ExpiredTime uint64
+}
+
 type ValidatorData struct {
 	Address common.Address
 	Message hexutil.Bytes
@@ -261,6 +280,46 @@ func (api *SignerAPI) determineSignatureFormat(ctx context.Context, contentType
 		// Clique uses V on the form 0 or 1
 		useEthereumV = false
 		req = &SignDataRequest{ContentType: mediaType, Rawdata: cliqueRlp, Messages: messages, Hash: sighash}
+	case SponsoredTxPayer.Mime:
+		// The payer signs the sponsored transaction before the sender
+		stringData, ok := data.(string)
+		if !ok {
+			return nil, useEthereumV, fmt.Errorf("input for %v must be an hex-encoded string", SponsoredTxPayer.Mime)
+		}
+		payerRlp, err := hexutil.Decode(stringData)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		var payerData SponsoredTxPayerData
+		if err := rlp.DecodeBytes(payerRlp, &payerData); err != nil {
+			return nil, useEthereumV, err
+		}
+		if payerData.ChainID == nil || payerData.ChainID.Cmp(api.chainID) != 0 {
+			return nil, useEthereumV, fmt.Errorf("requested chainid %v does not match the configuration of the signer", payerData.ChainID)
+		}
+		to := "contract creation"
+		if payerData.To != nil {
+			to = payerData.To.Hex()
+		}
+		messages := []*NameValueType{
+			{
+				Name:  "This is a request to pay the gas fee of a sponsored transaction",
+				Typ:   "description",
+				Value: "",
+			},
+			{Name: "Sender", Typ: "address", Value: payerData.Sender.Hex()},
+			{Name: "Nonce", Typ: "uint64", Value: fmt.Sprintf("%d", payerData.Nonce)},
+			{Name: "To", Typ: "address", Value: to},
+			{Name: "Value", Typ: "uint256", Value: payerData.Value.String()},
+			{Name: "Gas", Typ: "uint64", Value: fmt.Sprintf("%d", payerData.Gas)},
+			{Name: "Max fee per gas", Typ: "uint256", Value: payerData.GasFeeCap.String()},
+			{Name: "Max priority fee per gas", Typ: "uint256", Value: payerData.GasTipCap.String()},
+			{Name: "Expired time", Typ: "uint64", Value: fmt.Sprintf("%d", payerData.ExpiredTime)},
+			{Name: "Data", Typ: "hexdata", Value: hexutil.Bytes(payerData.Data)},
+		}
+		// The payer signature uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: payerRlp, Messages: messages, Hash: crypto.Keccak256(payerRlp)}
 	default: // also case TextPlain.Mime:
 		// Calculates an Ethereum ECDSA signature for:
 		// hash = keccak256("\x19${byteVersion}Ethereum Signed Message:\n${message length}${message}")